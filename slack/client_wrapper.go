@@ -1,19 +1,72 @@
 package slack
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/slack-go/slack"
 )
 
 // ClientWrapper wraps the real slack.Client to implement ClientInterface
 type ClientWrapper struct {
-	client *slack.Client
+	client     *slack.Client
+	token      string
+	endpoint   string
+	httpClient *http.Client
 }
 
-// NewClientWrapper creates a new wrapper around a slack.Client
-func NewClientWrapper(client *slack.Client) ClientInterface {
-	return &ClientWrapper{client: client}
+// NewClientWrapper creates a new wrapper around a slack.Client. slack.Client
+// doesn't expose its token, so it is passed again for the admin.conversations
+// and conversations.listConnectInvites methods that slack-go does not
+// implement yet. Methods slack-go implements must be delegated to client.
+func NewClientWrapper(client *slack.Client, token string) ClientInterface {
+	return &ClientWrapper{
+		client:     client,
+		token:      token,
+		endpoint:   slack.APIURL,
+		httpClient: &http.Client{},
+	}
+}
+
+// postMethod calls a Slack Web API method directly and decodes the JSON answer
+// into response. It is used for methods that slack-go does not wrap, and it
+// reports rate limiting as *slack.RateLimitedError so WithRetry can handle it.
+func (w *ClientWrapper) postMethod(ctx context.Context, method string, values url.Values, response interface{ Err() error }) error {
+	values.Set("token", w.token)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.endpoint+method, strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests && resp.Header.Get("Retry-After") != "" {
+		retryAfter, err := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 64)
+		if err != nil {
+			return err
+		}
+		return &slack.RateLimitedError{RetryAfter: time.Duration(retryAfter) * time.Second}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return slack.StatusCodeError{Code: resp.StatusCode, Status: resp.Status}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return fmt.Errorf("could not decode %s response: %w", method, err)
+	}
+	return response.Err()
 }
 
 // User operations
@@ -34,6 +87,24 @@ func (w *ClientWrapper) GetConversationInfoContext(ctx context.Context, input *s
 	return w.client.GetConversationInfoContext(ctx, input)
 }
 
+// GetConversationDetailsContext calls conversations.info through postMethod,
+// as slack-go doesn't decode the updated attribute.
+func (w *ClientWrapper) GetConversationDetailsContext(ctx context.Context, input *slack.GetConversationInfoInput) (*ConversationInfo, error) {
+	response := struct {
+		slack.SlackResponse
+		Channel ConversationInfo `json:"channel"`
+	}{}
+	err := w.postMethod(ctx, "conversations.info", url.Values{
+		"channel":             {input.ChannelID},
+		"include_locale":      {strconv.FormatBool(input.IncludeLocale)},
+		"include_num_members": {strconv.FormatBool(input.IncludeNumMembers)},
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Channel, nil
+}
+
 func (w *ClientWrapper) GetConversationsContext(ctx context.Context, params *slack.GetConversationsParameters) ([]slack.Channel, string, error) {
 	return w.client.GetConversationsContext(ctx, params)
 }
@@ -62,6 +133,25 @@ func (w *ClientWrapper) SetPurposeOfConversationContext(ctx context.Context, cha
 	return w.client.SetPurposeOfConversationContext(ctx, channelID, purpose)
 }
 
+func (w *ClientWrapper) InviteSharedToConversationContext(ctx context.Context, params slack.InviteSharedToConversationParams) (string, bool, error) {
+	return w.client.InviteSharedToConversationContext(ctx, params)
+}
+
+func (w *ClientWrapper) ListConnectInvitesContext(ctx context.Context, cursor string) ([]ConnectInvite, string, error) {
+	response := struct {
+		slack.SlackResponse
+		Invites []ConnectInvite `json:"invites"`
+	}{}
+	values := url.Values{
+		"count": {strconv.Itoa(cursorLimit)},
+	}
+	if cursor != "" {
+		values.Set("cursor", cursor)
+	}
+	err := w.postMethod(ctx, "conversations.listConnectInvites", values, &response)
+	return response.Invites, response.ResponseMetadata.Cursor, err
+}
+
 func (w *ClientWrapper) RenameConversationContext(ctx context.Context, channelID, name string) (*slack.Channel, error) {
 	return w.client.RenameConversationContext(ctx, channelID, name)
 }
@@ -74,6 +164,233 @@ func (w *ClientWrapper) UnArchiveConversationContext(ctx context.Context, channe
 	return w.client.UnArchiveConversationContext(ctx, channelID)
 }
 
+// Message operations
+func (w *ClientWrapper) PostMessageContext(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+	return w.client.PostMessageContext(ctx, channelID, options...)
+}
+
+func (w *ClientWrapper) UpdateMessageContext(ctx context.Context, channelID, timestamp string, options ...slack.MsgOption) (string, string, string, error) {
+	return w.client.UpdateMessageContext(ctx, channelID, timestamp, options...)
+}
+
+func (w *ClientWrapper) DeleteMessageContext(ctx context.Context, channelID, timestamp string) (string, string, error) {
+	return w.client.DeleteMessageContext(ctx, channelID, timestamp)
+}
+
+func (w *ClientWrapper) GetConversationHistoryContext(ctx context.Context, params *slack.GetConversationHistoryParameters) (*slack.GetConversationHistoryResponse, error) {
+	return w.client.GetConversationHistoryContext(ctx, params)
+}
+
+func (w *ClientWrapper) GetPermalinkContext(ctx context.Context, params *slack.PermalinkParameters) (string, error) {
+	return w.client.GetPermalinkContext(ctx, params)
+}
+
+// Pin operations
+func (w *ClientWrapper) AddPinContext(ctx context.Context, channelID string, item slack.ItemRef) error {
+	return w.client.AddPinContext(ctx, channelID, item)
+}
+
+func (w *ClientWrapper) RemovePinContext(ctx context.Context, channelID string, item slack.ItemRef) error {
+	return w.client.RemovePinContext(ctx, channelID, item)
+}
+
+func (w *ClientWrapper) ListPinsContext(ctx context.Context, channelID string) ([]slack.Item, *slack.Paging, error) {
+	return w.client.ListPinsContext(ctx, channelID)
+}
+
+// Canvas operations
+func (w *ClientWrapper) CreateChannelCanvasContext(ctx context.Context, channelID string, content slack.DocumentContent) (string, error) {
+	return w.client.CreateChannelCanvasContext(ctx, channelID, content)
+}
+
+func (w *ClientWrapper) EditCanvasContext(ctx context.Context, params slack.EditCanvasParams) error {
+	return w.client.EditCanvasContext(ctx, params)
+}
+
+func (w *ClientWrapper) DeleteCanvasContext(ctx context.Context, canvasID string) error {
+	return w.client.DeleteCanvasContext(ctx, canvasID)
+}
+
+func (w *ClientWrapper) GetCanvasContentContext(ctx context.Context, canvasID string) ([]byte, error) {
+	file, _, _, err := w.client.GetFileInfoContext(ctx, canvasID, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	var content bytes.Buffer
+	if err := w.client.GetFileContext(ctx, file.URLPrivateDownload, &content); err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// Admin conversation operations
+func (w *ClientWrapper) AdminConversationsGetConversationPrefsContext(ctx context.Context, channelID string) (*ConversationPrefs, error) {
+	response := struct {
+		slack.SlackResponse
+		Prefs ConversationPrefs `json:"prefs"`
+	}{}
+	err := w.postMethod(ctx, "admin.conversations.getConversationPrefs", url.Values{
+		"channel_id": {channelID},
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Prefs, nil
+}
+
+func (w *ClientWrapper) AdminConversationsSetConversationPrefsContext(ctx context.Context, channelID string, prefs map[string]string) error {
+	encodedPrefs, err := json.Marshal(prefs)
+	if err != nil {
+		return err
+	}
+	response := slack.SlackResponse{}
+	return w.postMethod(ctx, "admin.conversations.setConversationPrefs", url.Values{
+		"channel_id": {channelID},
+		"prefs":      {string(encodedPrefs)},
+	}, &response)
+}
+
+func (w *ClientWrapper) AdminConversationsGetCustomRetentionContext(ctx context.Context, channelID string) (int, bool, error) {
+	response := struct {
+		slack.SlackResponse
+		DurationDays    int  `json:"duration_days"`
+		IsPolicyEnabled bool `json:"is_policy_enabled"`
+	}{}
+	err := w.postMethod(ctx, "admin.conversations.getCustomRetention", url.Values{
+		"channel_id": {channelID},
+	}, &response)
+	return response.DurationDays, response.IsPolicyEnabled, err
+}
+
+func (w *ClientWrapper) AdminConversationsSetCustomRetentionContext(ctx context.Context, channelID string, durationDays int) error {
+	response := slack.SlackResponse{}
+	return w.postMethod(ctx, "admin.conversations.setCustomRetention", url.Values{
+		"channel_id":    {channelID},
+		"duration_days": {strconv.Itoa(durationDays)},
+	}, &response)
+}
+
+func (w *ClientWrapper) AdminConversationsRemoveCustomRetentionContext(ctx context.Context, channelID string) error {
+	response := slack.SlackResponse{}
+	return w.postMethod(ctx, "admin.conversations.removeCustomRetention", url.Values{
+		"channel_id": {channelID},
+	}, &response)
+}
+
+func (w *ClientWrapper) AdminConversationsDisconnectSharedContext(ctx context.Context, channelID string, leavingTeamIDs []string) error {
+	response := slack.SlackResponse{}
+	return w.postMethod(ctx, "admin.conversations.disconnectShared", url.Values{
+		"channel_id":       {channelID},
+		"leaving_team_ids": {strings.Join(leavingTeamIDs, ",")},
+	}, &response)
+}
+
+func (w *ClientWrapper) AdminConversationsRestrictAccessAddGroupContext(ctx context.Context, channelID, groupID, teamID string) error {
+	response := slack.SlackResponse{}
+	values := url.Values{
+		"channel_id": {channelID},
+		"group_id":   {groupID},
+	}
+	if teamID != "" {
+		values.Set("team_id", teamID)
+	}
+	return w.postMethod(ctx, "admin.conversations.restrictAccess.addGroup", values, &response)
+}
+
+func (w *ClientWrapper) AdminConversationsRestrictAccessRemoveGroupContext(ctx context.Context, channelID, groupID, teamID string) error {
+	response := slack.SlackResponse{}
+	values := url.Values{
+		"channel_id": {channelID},
+		"group_id":   {groupID},
+	}
+	if teamID != "" {
+		values.Set("team_id", teamID)
+	}
+	return w.postMethod(ctx, "admin.conversations.restrictAccess.removeGroup", values, &response)
+}
+
+func (w *ClientWrapper) AdminConversationsRestrictAccessListGroupsContext(ctx context.Context, channelID, teamID string) ([]string, error) {
+	response := struct {
+		slack.SlackResponse
+		GroupIDs []string `json:"group_ids"`
+	}{}
+	values := url.Values{
+		"channel_id": {channelID},
+	}
+	if teamID != "" {
+		values.Set("team_id", teamID)
+	}
+	err := w.postMethod(ctx, "admin.conversations.restrictAccess.listGroups", values, &response)
+	return response.GroupIDs, err
+}
+
+func (w *ClientWrapper) AdminConversationsCreateContext(ctx context.Context, name string, isPrivate, orgWide bool, teamID string) (string, error) {
+	response := struct {
+		slack.SlackResponse
+		ChannelID string `json:"channel_id"`
+	}{}
+	values := url.Values{
+		"name":       {name},
+		"is_private": {strconv.FormatBool(isPrivate)},
+		"org_wide":   {strconv.FormatBool(orgWide)},
+	}
+	if teamID != "" {
+		values.Set("team_id", teamID)
+	}
+	err := w.postMethod(ctx, "admin.conversations.create", values, &response)
+	return response.ChannelID, err
+}
+
+func (w *ClientWrapper) AdminConversationsSetTeamsContext(ctx context.Context, params slack.AdminConversationsSetTeamsParams) error {
+	return w.client.AdminConversationsSetTeams(ctx, params)
+}
+
+func (w *ClientWrapper) AdminConversationsGetTeamsContext(ctx context.Context, channelID, cursor string) ([]string, string, error) {
+	response := struct {
+		slack.SlackResponse
+		TeamIDs []string `json:"team_ids"`
+	}{}
+	values := url.Values{
+		"channel_id": {channelID},
+		"limit":      {strconv.Itoa(cursorLimit)},
+	}
+	if cursor != "" {
+		values.Set("cursor", cursor)
+	}
+	err := w.postMethod(ctx, "admin.conversations.getTeams", values, &response)
+	return response.TeamIDs, response.ResponseMetadata.Cursor, err
+}
+
+func (w *ClientWrapper) AdminConversationsRenameContext(ctx context.Context, channelID, name string) error {
+	response := slack.SlackResponse{}
+	return w.postMethod(ctx, "admin.conversations.rename", url.Values{
+		"channel_id": {channelID},
+		"name":       {name},
+	}, &response)
+}
+
+func (w *ClientWrapper) AdminConversationsConvertToPrivateContext(ctx context.Context, channelID string) error {
+	return w.client.AdminConversationsConvertToPrivate(ctx, channelID)
+}
+
+func (w *ClientWrapper) AdminConversationsConvertToPublicContext(ctx context.Context, channelID string) error {
+	return w.client.AdminConversationsConvertToPublic(ctx, channelID)
+}
+
+func (w *ClientWrapper) AdminConversationsArchiveContext(ctx context.Context, channelID string) error {
+	response := slack.SlackResponse{}
+	return w.postMethod(ctx, "admin.conversations.archive", url.Values{
+		"channel_id": {channelID},
+	}, &response)
+}
+
+func (w *ClientWrapper) AdminConversationsDeleteContext(ctx context.Context, channelID string) error {
+	response := slack.SlackResponse{}
+	return w.postMethod(ctx, "admin.conversations.delete", url.Values{
+		"channel_id": {channelID},
+	}, &response)
+}
+
 // User group operations
 func (w *ClientWrapper) CreateUserGroupContext(ctx context.Context, userGroup slack.UserGroup, options ...slack.CreateUserGroupOption) (slack.UserGroup, error) {
 	return w.client.CreateUserGroupContext(ctx, userGroup, options...)
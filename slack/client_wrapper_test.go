@@ -0,0 +1,113 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func testClientWrapper(t *testing.T, handler http.HandlerFunc) *ClientWrapper {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &ClientWrapper{
+		client:     slack.New("xoxp-test", slack.OptionAPIURL(server.URL+"/")),
+		token:      "xoxp-test",
+		endpoint:   server.URL + "/",
+		httpClient: server.Client(),
+	}
+}
+
+func TestClientWrapperPostMethod(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		retryAfter    string
+		body          string
+		expectedValue string
+		expectedError string
+		rateLimited   time.Duration
+	}{
+		{
+			name:          "successful call",
+			status:        http.StatusOK,
+			body:          `{"ok": true, "value": "abc"}`,
+			expectedValue: "abc",
+		},
+		{
+			name:          "slack error",
+			status:        http.StatusOK,
+			body:          `{"ok": false, "error": "not_an_admin"}`,
+			expectedError: "not_an_admin",
+		},
+		{
+			name:        "rate limited",
+			status:      http.StatusTooManyRequests,
+			retryAfter:  "3",
+			rateLimited: 3 * time.Second,
+		},
+		{
+			name:          "server error",
+			status:        http.StatusInternalServerError,
+			expectedError: "slack server error: 500 Internal Server Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := testClientWrapper(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/admin.test.method", r.URL.Path)
+				assert.NoError(t, r.ParseForm())
+				assert.Equal(t, "xoxp-test", r.PostForm.Get("token"))
+				assert.Equal(t, "C123", r.PostForm.Get("channel_id"))
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+
+			response := struct {
+				slack.SlackResponse
+				Value string `json:"value"`
+			}{}
+			err := wrapper.postMethod(context.Background(), "admin.test.method", url.Values{"channel_id": {"C123"}}, &response)
+
+			switch {
+			case tt.rateLimited > 0:
+				var rateLimitErr *slack.RateLimitedError
+				assert.True(t, errors.As(err, &rateLimitErr))
+				assert.Equal(t, tt.rateLimited, rateLimitErr.RetryAfter)
+			case tt.expectedError != "":
+				assert.EqualError(t, err, tt.expectedError)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedValue, response.Value)
+			}
+		})
+	}
+}
+
+func TestClientWrapperGetConversationDetails(t *testing.T) {
+	wrapper := testClientWrapper(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/conversations.info", r.URL.Path)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "C123", r.PostForm.Get("channel"))
+		_, _ = w.Write([]byte(`{"ok": true, "channel": {"id": "C123", "name": "general", "is_private": true, "updated": 1678229664302}}`))
+	})
+
+	info, err := wrapper.GetConversationDetailsContext(context.Background(), &slack.GetConversationInfoInput{ChannelID: "C123"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "C123", info.ID)
+	assert.Equal(t, "general", info.Name)
+	assert.True(t, info.IsPrivate)
+	assert.Equal(t, int64(1678229664302), info.Updated)
+}
@@ -2,12 +2,24 @@ package slack
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/slack-go/slack"
 )
 
+// conversationTypes maps the conversation types accepted by the configuration
+// to the ones of the Slack API
+var conversationTypes = map[string]string{
+	"public":  "public_channel",
+	"private": "private_channel",
+	"mpim":    "mpim",
+	"im":      "im",
+}
+
 func dataSourceConversation() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceSlackConversationRead,
@@ -22,8 +34,26 @@ func dataSourceConversation() *schema.Resource {
 				Optional: true,
 			},
 			"is_private": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"types"},
+			},
+			"types": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"public", "private", "mpim", "im"}, false),
+				},
+				Set:           schema.HashString,
+				Description:   "Types of conversations to search by name, any of public, private, mpim or im",
+				Optional:      true,
+				ConflictsWith: []string{"is_private"},
+			},
+			"include_archived": {
+				Type:        schema.TypeBool,
+				Description: "Also search archived conversations by name",
+				Optional:    true,
+				Default:     false,
 			},
 			"topic": {
 				Type:     schema.TypeString,
@@ -37,6 +67,11 @@ func dataSourceConversation() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"updated": {
+				Type:        schema.TypeInt,
+				Description: "Unix timestamp in milliseconds of the last update of the channel",
+				Computed:    true,
+			},
 			"creator": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -61,6 +96,51 @@ func dataSourceConversation() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"num_members": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"is_member": {
+				Type:        schema.TypeBool,
+				Description: "Whether the user of the token is a member of the conversation",
+				Computed:    true,
+			},
+			"context_team_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shared_team_ids": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+			"pending_shared": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"topic_last_set_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"purpose_last_set_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -71,43 +151,81 @@ func dataSourceSlackConversationRead(ctx context.Context, d *schema.ResourceData
 	channelID := d.Get("channel_id").(string)
 	channelName := d.Get("name").(string)
 	isPrivate := d.Get("is_private").(bool)
+	includeArchived := d.Get("include_archived").(bool)
+
+	var types []string // default value with empty list is "public_channel"
+	if isPrivate {
+		types = append(types, conversationTypes["private"])
+	}
+	for _, t := range schemaSetToSlice(d.Get("types").(*schema.Set)) {
+		types = append(types, conversationTypes[t])
+	}
 
 	var (
-		channel *slack.Channel
-		users   []string
-		err     error
+		users []string
+		err   error
 	)
 
-	if channelID != "" {
-		channel, err = WithRetryWithResult(ctx, config.RetryConfig, func() (*slack.Channel, error) {
-			return client.GetConversationInfoContext(ctx, &slack.GetConversationInfoInput{
-				ChannelID: channelID,
-			})
+	if channelID == "" && channelName != "" {
+		channels, err := WithRetryWithResult(ctx, config.RetryConfig, func() ([]slack.Channel, error) {
+			return findChannelsByName(ctx, client, channelName, types, includeArchived)
 		})
 		if err != nil {
-			return diag.Errorf("couldn't get conversation info for %s: %s", channelID, err)
+			return diag.Errorf("couldn't get conversation info for %s: %s", channelName, err)
 		}
-	} else if channelName != "" {
-		channel, err = WithRetryWithResult(ctx, config.RetryConfig, func() (*slack.Channel, error) {
-			return findExistingChannel(ctx, client, channelName, isPrivate)
-		})
+		channel, err := singleChannel(channelName, channels)
 		if err != nil {
-			return diag.Errorf("couldn't get conversation info for %s: %s", channelName, err)
+			return diag.FromErr(err)
 		}
-	} else {
+		// conversations.list doesn't return every attribute, e.g. the locale
+		channelID = channel.ID
+	}
+	if channelID == "" {
 		return diag.Errorf("channel_id or name must be set")
 	}
 
+	info, err := WithRetryWithResult(ctx, config.RetryConfig, func() (*ConversationInfo, error) {
+		return client.GetConversationDetailsContext(ctx, &slack.GetConversationInfoInput{
+			ChannelID:         channelID,
+			IncludeNumMembers: true,
+			IncludeLocale:     true,
+		})
+	})
+	if err != nil {
+		return diag.Errorf("couldn't get conversation info for %s: %s", channelID, err)
+	}
+
 	err = WithRetry(ctx, config.RetryConfig, func() error {
 		var retryErr error
 		users, _, retryErr = client.GetUsersInConversationContext(ctx, &slack.GetUsersInConversationParameters{
-			ChannelID: channel.ID,
+			ChannelID: info.ID,
 		})
 		return retryErr
 	})
 	if err != nil {
-		return diag.Errorf("couldn't get users in conversation for %s: %s", channel.ID, err)
+		return diag.Errorf("couldn't get users in conversation for %s: %s", info.ID, err)
+	}
+
+	apiUserInfo, err := config.authTest(ctx, config.RetryConfig)
+	if err != nil {
+		return diag.Errorf("error authenticating with slack %s", err)
 	}
 
-	return updateChannelData(d, channel, users)
+	return updateChannelData(d, info, users, apiUserInfo.URL)
+}
+
+// singleChannel returns the only conversation found with the name
+func singleChannel(name string, channels []slack.Channel) (*slack.Channel, error) {
+	switch len(channels) {
+	case 0:
+		return nil, fmt.Errorf("could not find channel with name %s", name)
+	case 1:
+		return &channels[0], nil
+	}
+	ids := make([]string, len(channels))
+	for i, c := range channels {
+		ids[i] = c.ID
+	}
+	return nil, fmt.Errorf("found %d conversations with name %s (%s), use channel_id or narrow down types and include_archived",
+		len(channels), name, strings.Join(ids, ", "))
 }
@@ -0,0 +1,117 @@
+package slack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func testChannel(id, name string, isArchived bool) slack.Channel {
+	return slack.Channel{
+		GroupConversation: slack.GroupConversation{
+			Conversation: slack.Conversation{ID: id},
+			Name:         name,
+			IsArchived:   isArchived,
+		},
+	}
+}
+
+func TestDataSourceSlackConversationRead_ByName(t *testing.T) {
+	tests := []struct {
+		name                    string
+		data                    map[string]interface{}
+		channels                []slack.Channel
+		expectedTypes           []string
+		expectedExcludeArchived bool
+		expectedID              string
+		expectedError           string
+	}{
+		{
+			name:                    "public by default",
+			data:                    map[string]interface{}{"name": "general"},
+			channels:                []slack.Channel{testChannel("C001", "general", false), testChannel("C002", "random", false)},
+			expectedExcludeArchived: true,
+			expectedID:              "C001",
+		},
+		{
+			name:                    "is_private",
+			data:                    map[string]interface{}{"name": "secret", "is_private": true},
+			channels:                []slack.Channel{testChannel("G001", "secret", false)},
+			expectedTypes:           []string{"private_channel"},
+			expectedExcludeArchived: true,
+			expectedID:              "G001",
+		},
+		{
+			name: "any type including archived",
+			data: map[string]interface{}{
+				"name":             "project",
+				"types":            []interface{}{"public", "private"},
+				"include_archived": true,
+			},
+			channels:      []slack.Channel{testChannel("G001", "project", true)},
+			expectedTypes: []string{"private_channel", "public_channel"},
+			expectedID:    "G001",
+		},
+		{
+			name: "multiple matches",
+			data: map[string]interface{}{
+				"name":             "project",
+				"types":            []interface{}{"public", "private"},
+				"include_archived": true,
+			},
+			channels:      []slack.Channel{testChannel("C001", "project", true), testChannel("G001", "project", false)},
+			expectedTypes: []string{"private_channel", "public_channel"},
+			expectedError: "found 2 conversations with name project (C001, G001)",
+		},
+		{
+			name:                    "not found",
+			data:                    map[string]interface{}{"name": "missing"},
+			channels:                []slack.Channel{testChannel("C001", "general", false)},
+			expectedExcludeArchived: true,
+			expectedError:           "could not find channel with name missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockSlackClient{
+				MockGetConversations: func(_ context.Context, params *slack.GetConversationsParameters) ([]slack.Channel, string, error) {
+					assert.ElementsMatch(t, tt.expectedTypes, params.Types)
+					assert.Equal(t, tt.expectedExcludeArchived, params.ExcludeArchived)
+					return tt.channels, "", nil
+				},
+				MockGetConversationDetails: func(_ context.Context, input *slack.GetConversationInfoInput) (*ConversationInfo, error) {
+					channel := testChannel(input.ChannelID, tt.data["name"].(string), false)
+					if input.IncludeLocale {
+						channel.Locale = "en-GB"
+					}
+					return &ConversationInfo{Channel: channel, Updated: 1678229664302}, nil
+				},
+				MockAuthTest: func() (*slack.AuthTestResponse, error) {
+					return &slack.AuthTestResponse{URL: "https://example.slack.com/"}, nil
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, dataSourceConversation().Schema, tt.data)
+
+			diags := dataSourceSlackConversationRead(context.Background(), resourceData, config)
+
+			if tt.expectedError != "" {
+				assert.True(t, diags.HasError())
+				assert.Contains(t, diags[0].Summary, tt.expectedError)
+				return
+			}
+			assert.Empty(t, diags)
+			assert.Equal(t, tt.expectedID, resourceData.Id())
+			assert.Equal(t, "en-GB", resourceData.Get("locale"))
+			assert.Equal(t, 1678229664302, resourceData.Get("updated"))
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package slack
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/slack-go/slack"
+)
+
+func dataSourceConversations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSlackConversationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"types": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"public", "private", "mpim", "im"}, false),
+				},
+				Set:         schema.HashString,
+				Description: "Types of conversations to list, any of public, private, mpim or im",
+				Optional:    true,
+			},
+			"include_archived": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"member_only": {
+				Type:        schema.TypeBool,
+				Description: "Only list conversations the user of the token is a member of",
+				Optional:    true,
+				Default:     false,
+			},
+			"min_members": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"ids": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"conversations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"topic": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"purpose": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"creator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_private": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_archived": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_member": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"num_members": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSlackConversationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	namePrefix := d.Get("name_prefix").(string)
+	includeArchived := d.Get("include_archived").(bool)
+	memberOnly := d.Get("member_only").(bool)
+	minMembers := d.Get("min_members").(int)
+
+	var nameRegex *regexp.Regexp
+	if v := d.Get("name_regex").(string); v != "" {
+		nameRegex = regexp.MustCompile(v)
+	}
+
+	var types []string // default value with empty list is "public_channel"
+	for _, t := range schemaSetToSlice(d.Get("types").(*schema.Set)) {
+		types = append(types, conversationTypes[t])
+	}
+
+	channels, err := WithRetryWithResult(ctx, config.RetryConfig, func() ([]slack.Channel, error) {
+		return searchChannels(ctx, client, types, includeArchived, func(c slack.Channel) bool {
+			return strings.HasPrefix(c.Name, namePrefix) &&
+				(nameRegex == nil || nameRegex.MatchString(c.Name)) &&
+				(!memberOnly || c.IsMember) &&
+				c.NumMembers >= minMembers
+		})
+	})
+	if err != nil {
+		return diag.Errorf("couldn't list conversations: %s", err)
+	}
+
+	apiUserInfo, err := config.authTest(ctx, config.RetryConfig)
+	if err != nil {
+		return diag.Errorf("error authenticating with slack %s", err)
+	}
+
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].Name < channels[j].Name
+	})
+
+	ids := make([]string, len(channels))
+	conversations := make([]map[string]interface{}, len(channels))
+	for i, c := range channels {
+		ids[i] = c.ID
+		conversations[i] = map[string]interface{}{
+			"id":          c.ID,
+			"name":        c.Name,
+			"topic":       c.Topic.Value,
+			"purpose":     c.Purpose.Value,
+			"created":     int(c.Created),
+			"creator":     c.Creator,
+			"is_private":  c.IsPrivate,
+			"is_archived": c.IsArchived,
+			"is_member":   c.IsMember,
+			"num_members": c.NumMembers,
+			"url":         conversationURL(apiUserInfo.URL, c.ID),
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.Errorf("error setting ids: %s", err)
+	}
+
+	if err := d.Set("conversations", conversations); err != nil {
+		return diag.Errorf("error setting conversations: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package slack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccSlackConversationsDataSource(t *testing.T) {
+	var providers []*schema.Provider
+
+	prefix := acctest.RandomWithPrefix(conversationNamePrefix)
+	dataSourceName := "data.slack_conversations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckConversationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackConversationsDataSourceConfig(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "slack_conversation.first", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.1", "slack_conversation.second", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "conversations.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "conversations.0.name", "slack_conversation.first", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "conversations.0.is_private", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "conversations.1.name", "slack_conversation.second", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackConversationsDataSourceConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource slack_conversation first {
+  name              = "%[1]s-a"
+  is_private        = true
+  permanent_members = []
+}
+
+resource slack_conversation second {
+  name              = "%[1]s-b"
+  is_private        = true
+  permanent_members = []
+}
+
+data slack_conversations test {
+  name_prefix = "%[1]s"
+  types       = ["private"]
+
+  depends_on = [slack_conversation.first, slack_conversation.second]
+}
+`, prefix)
+}
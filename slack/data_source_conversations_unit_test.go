@@ -0,0 +1,149 @@
+package slack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceSlackConversationsRead(t *testing.T) {
+	pages := map[string][]slack.Channel{
+		"": {
+			{
+				GroupConversation: slack.GroupConversation{Conversation: slack.Conversation{ID: "C003", NumMembers: 10}, Name: "team-payments"},
+				IsMember:          true,
+			},
+			{
+				GroupConversation: slack.GroupConversation{Conversation: slack.Conversation{ID: "C004", NumMembers: 50}, Name: "random"},
+				IsMember:          true,
+			},
+		},
+		"next": {
+			{
+				GroupConversation: slack.GroupConversation{Conversation: slack.Conversation{ID: "C001", NumMembers: 2}, Name: "team-infra"},
+			},
+			{
+				GroupConversation: slack.GroupConversation{Conversation: slack.Conversation{ID: "C002", NumMembers: 30}, Name: "team-data-alerts"},
+				IsMember:          true,
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		data        map[string]interface{}
+		expectedIDs []string
+	}{
+		{
+			name:        "all",
+			data:        map[string]interface{}{},
+			expectedIDs: []string{"C004", "C002", "C001", "C003"},
+		},
+		{
+			name:        "name prefix",
+			data:        map[string]interface{}{"name_prefix": "team-"},
+			expectedIDs: []string{"C002", "C001", "C003"},
+		},
+		{
+			name:        "name regex",
+			data:        map[string]interface{}{"name_regex": "-alerts$"},
+			expectedIDs: []string{"C002"},
+		},
+		{
+			name:        "member only",
+			data:        map[string]interface{}{"name_prefix": "team-", "member_only": true},
+			expectedIDs: []string{"C002", "C003"},
+		},
+		{
+			name:        "min members",
+			data:        map[string]interface{}{"name_prefix": "team-", "min_members": 10},
+			expectedIDs: []string{"C002", "C003"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockSlackClient{
+				MockGetConversations: func(_ context.Context, params *slack.GetConversationsParameters) ([]slack.Channel, string, error) {
+					if params.Cursor == "" {
+						return pages[""], "next", nil
+					}
+					return pages[params.Cursor], "", nil
+				},
+				MockAuthTest: func() (*slack.AuthTestResponse, error) {
+					return &slack.AuthTestResponse{URL: "https://example.slack.com/"}, nil
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, dataSourceConversations().Schema, tt.data)
+
+			diags := dataSourceSlackConversationsRead(context.Background(), resourceData, config)
+
+			assert.Empty(t, diags)
+			ids := make([]string, 0)
+			for _, id := range resourceData.Get("ids").([]interface{}) {
+				ids = append(ids, id.(string))
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+			assert.Len(t, resourceData.Get("conversations").([]interface{}), len(tt.expectedIDs))
+		})
+	}
+}
+
+func TestDataSourceSlackConversationsRead_Attributes(t *testing.T) {
+	mockClient := &MockSlackClient{
+		MockGetConversations: func(_ context.Context, params *slack.GetConversationsParameters) ([]slack.Channel, string, error) {
+			assert.Equal(t, []string{"private_channel"}, params.Types)
+			assert.False(t, params.ExcludeArchived)
+			return []slack.Channel{
+				{
+					GroupConversation: slack.GroupConversation{
+						Conversation: slack.Conversation{ID: "G001", NumMembers: 3, IsPrivate: true, Created: 1700000000},
+						Name:         "team-secret",
+						Creator:      "U001",
+						IsArchived:   true,
+						Topic:        slack.Topic{Value: "topic"},
+						Purpose:      slack.Purpose{Value: "purpose"},
+					},
+					IsMember: true,
+				},
+			}, "", nil
+		},
+		MockAuthTest: func() (*slack.AuthTestResponse, error) {
+			return &slack.AuthTestResponse{URL: "https://example.slack.com/"}, nil
+		},
+	}
+	config := &ProviderConfig{
+		Client:      mockClient,
+		RetryConfig: DefaultRetryConfig(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, dataSourceConversations().Schema, map[string]interface{}{
+		"types":            []interface{}{"private"},
+		"include_archived": true,
+	})
+
+	diags := dataSourceSlackConversationsRead(context.Background(), resourceData, config)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, map[string]interface{}{
+		"id":          "G001",
+		"name":        "team-secret",
+		"topic":       "topic",
+		"purpose":     "purpose",
+		"created":     1700000000,
+		"creator":     "U001",
+		"is_private":  true,
+		"is_archived": true,
+		"is_member":   true,
+		"num_members": 3,
+		"url":         "https://example.slack.com/archives/G001",
+	}, resourceData.Get("conversations.0"))
+}
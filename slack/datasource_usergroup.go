@@ -48,6 +48,58 @@ func dataSourceUserGroup() *schema.Resource {
 				Set:      schema.HashString,
 				Computed: true,
 			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"team_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_external": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"auto_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_create": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"date_update": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"date_delete": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deleted_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"groups": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:      schema.HashString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/slack-go/slack"
 )
 
@@ -23,6 +25,34 @@ func DefaultRetryConfig() *RetryConfig {
 	}
 }
 
+// resourceOperationTimeout is the default deadline of each operation of a
+// resource with a timeouts block, matching the SDK default when none is declared
+const resourceOperationTimeout = 20 * time.Minute
+
+// resourceTimeouts returns the timeouts of a resource whose operations are
+// retried when rate limited
+func resourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(resourceOperationTimeout),
+		Read:   schema.DefaultTimeout(resourceOperationTimeout),
+		Update: schema.DefaultTimeout(resourceOperationTimeout),
+		Delete: schema.DefaultTimeout(resourceOperationTimeout),
+	}
+}
+
+// resourceRetryConfig returns the retry configuration of an operation, using
+// its timeout from the resource timeouts block
+func resourceRetryConfig(d *schema.ResourceData, key string) *RetryConfig {
+	return &RetryConfig{Timeout: d.Timeout(key)}
+}
+
+func objectAttribute(value cty.Value, name string) (cty.Value, bool) {
+	if value.IsNull() || !value.IsKnown() || !value.Type().IsObjectType() || !value.Type().HasAttribute(name) {
+		return cty.NilVal, false
+	}
+	return value.GetAttr(name), true
+}
+
 // WithRetry executes a function with retry logic for rate limiting and other transient errors
 func WithRetry(ctx context.Context, config *RetryConfig, operation func() error) error {
 	return retry.RetryContext(ctx, config.Timeout, func() *retry.RetryError {
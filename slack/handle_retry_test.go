@@ -6,6 +6,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/slack-go/slack"
 	"github.com/stretchr/testify/assert"
 )
@@ -16,6 +18,38 @@ func TestDefaultRetryConfig(t *testing.T) {
 	assert.Equal(t, DefaultRetryTimeout(), config.Timeout)
 }
 
+func TestResourceRetryConfig(t *testing.T) {
+	tests := []struct {
+		name           string
+		createTimeout  time.Duration
+		expectedCreate time.Duration
+	}{
+		{
+			name:           "default",
+			expectedCreate: resourceOperationTimeout,
+		},
+		{
+			name:           "create configured",
+			createTimeout:  30 * time.Minute,
+			expectedCreate: 30 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := resourceSlackConversation()
+			if tt.createTimeout != 0 {
+				// the SDK sets the configured timeouts before calling the resource
+				r.Timeouts.Create = schema.DefaultTimeout(tt.createTimeout)
+			}
+			d := r.Data(&terraform.InstanceState{})
+
+			assert.Equal(t, tt.expectedCreate, resourceRetryConfig(d, schema.TimeoutCreate).Timeout)
+			assert.Equal(t, resourceOperationTimeout, resourceRetryConfig(d, schema.TimeoutRead).Timeout)
+		})
+	}
+}
+
 func TestWithRetry_Success(t *testing.T) {
 	ctx := context.Background()
 	config := &RetryConfig{Timeout: 1 * time.Second}
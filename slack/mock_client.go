@@ -18,6 +18,7 @@ type ClientInterface interface {
 	// Conversation operations
 	CreateConversationContext(ctx context.Context, params slack.CreateConversationParams) (*slack.Channel, error)
 	GetConversationInfoContext(ctx context.Context, input *slack.GetConversationInfoInput) (*slack.Channel, error)
+	GetConversationDetailsContext(ctx context.Context, input *slack.GetConversationInfoInput) (*ConversationInfo, error)
 	GetConversationsContext(ctx context.Context, params *slack.GetConversationsParameters) ([]slack.Channel, string, error)
 	GetUsersInConversationContext(ctx context.Context, params *slack.GetUsersInConversationParameters) ([]string, string, error)
 	JoinConversationContext(ctx context.Context, channelID string) (*slack.Channel, string, []string, error)
@@ -25,10 +26,49 @@ type ClientInterface interface {
 	KickUserFromConversationContext(ctx context.Context, channelID, user string) error
 	SetTopicOfConversationContext(ctx context.Context, channelID, topic string) (*slack.Channel, error)
 	SetPurposeOfConversationContext(ctx context.Context, channelID, purpose string) (*slack.Channel, error)
+	InviteSharedToConversationContext(ctx context.Context, params slack.InviteSharedToConversationParams) (string, bool, error)
+	ListConnectInvitesContext(ctx context.Context, cursor string) ([]ConnectInvite, string, error)
 	RenameConversationContext(ctx context.Context, channelID, name string) (*slack.Channel, error)
 	ArchiveConversationContext(ctx context.Context, channelID string) error
 	UnArchiveConversationContext(ctx context.Context, channelID string) error
 
+	// Message operations
+	PostMessageContext(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error)
+	UpdateMessageContext(ctx context.Context, channelID, timestamp string, options ...slack.MsgOption) (string, string, string, error)
+	DeleteMessageContext(ctx context.Context, channelID, timestamp string) (string, string, error)
+	GetConversationHistoryContext(ctx context.Context, params *slack.GetConversationHistoryParameters) (*slack.GetConversationHistoryResponse, error)
+	GetPermalinkContext(ctx context.Context, params *slack.PermalinkParameters) (string, error)
+
+	// Pin operations
+	AddPinContext(ctx context.Context, channelID string, item slack.ItemRef) error
+	RemovePinContext(ctx context.Context, channelID string, item slack.ItemRef) error
+	ListPinsContext(ctx context.Context, channelID string) ([]slack.Item, *slack.Paging, error)
+
+	// Canvas operations
+	CreateChannelCanvasContext(ctx context.Context, channelID string, content slack.DocumentContent) (string, error)
+	EditCanvasContext(ctx context.Context, params slack.EditCanvasParams) error
+	DeleteCanvasContext(ctx context.Context, canvasID string) error
+	GetCanvasContentContext(ctx context.Context, canvasID string) ([]byte, error)
+
+	// Admin conversation operations
+	AdminConversationsGetConversationPrefsContext(ctx context.Context, channelID string) (*ConversationPrefs, error)
+	AdminConversationsSetConversationPrefsContext(ctx context.Context, channelID string, prefs map[string]string) error
+	AdminConversationsGetCustomRetentionContext(ctx context.Context, channelID string) (int, bool, error)
+	AdminConversationsSetCustomRetentionContext(ctx context.Context, channelID string, durationDays int) error
+	AdminConversationsRemoveCustomRetentionContext(ctx context.Context, channelID string) error
+	AdminConversationsDisconnectSharedContext(ctx context.Context, channelID string, leavingTeamIDs []string) error
+	AdminConversationsRestrictAccessAddGroupContext(ctx context.Context, channelID, groupID, teamID string) error
+	AdminConversationsRestrictAccessRemoveGroupContext(ctx context.Context, channelID, groupID, teamID string) error
+	AdminConversationsRestrictAccessListGroupsContext(ctx context.Context, channelID, teamID string) ([]string, error)
+	AdminConversationsCreateContext(ctx context.Context, name string, isPrivate, orgWide bool, teamID string) (string, error)
+	AdminConversationsSetTeamsContext(ctx context.Context, params slack.AdminConversationsSetTeamsParams) error
+	AdminConversationsGetTeamsContext(ctx context.Context, channelID, cursor string) ([]string, string, error)
+	AdminConversationsRenameContext(ctx context.Context, channelID, name string) error
+	AdminConversationsConvertToPrivateContext(ctx context.Context, channelID string) error
+	AdminConversationsConvertToPublicContext(ctx context.Context, channelID string) error
+	AdminConversationsArchiveContext(ctx context.Context, channelID string) error
+	AdminConversationsDeleteContext(ctx context.Context, channelID string) error
+
 	// User group operations
 	CreateUserGroupContext(ctx context.Context, userGroup slack.UserGroup, options ...slack.CreateUserGroupOption) (slack.UserGroup, error)
 	GetUserGroupsContext(ctx context.Context, options ...slack.GetUserGroupsOption) ([]slack.UserGroup, error)
@@ -50,18 +90,58 @@ type MockSlackClient struct {
 	MockGetUsers       func(ctx context.Context) ([]slack.User, error)
 
 	// Conversation mocks
-	MockCreateConversation        func(ctx context.Context, params slack.CreateConversationParams) (*slack.Channel, error)
-	MockGetConversationInfo       func(ctx context.Context, input *slack.GetConversationInfoInput) (*slack.Channel, error)
-	MockGetConversations          func(ctx context.Context, params *slack.GetConversationsParameters) ([]slack.Channel, string, error)
-	MockGetUsersInConversation    func(ctx context.Context, params *slack.GetUsersInConversationParameters) ([]string, string, error)
-	MockJoinConversation          func(ctx context.Context, channelID string) (*slack.Channel, string, []string, error)
-	MockInviteUsersToConversation func(ctx context.Context, channelID string, users ...string) (*slack.Channel, error)
-	MockKickUserFromConversation  func(ctx context.Context, channelID, user string) error
-	MockSetTopicOfConversation    func(ctx context.Context, channelID, topic string) (*slack.Channel, error)
-	MockSetPurposeOfConversation  func(ctx context.Context, channelID, purpose string) (*slack.Channel, error)
-	MockRenameConversation        func(ctx context.Context, channelID, name string) (*slack.Channel, error)
-	MockArchiveConversation       func(ctx context.Context, channelID string) error
-	MockUnArchiveConversation     func(ctx context.Context, channelID string) error
+	MockCreateConversation         func(ctx context.Context, params slack.CreateConversationParams) (*slack.Channel, error)
+	MockGetConversationInfo        func(ctx context.Context, input *slack.GetConversationInfoInput) (*slack.Channel, error)
+	MockGetConversationDetails     func(ctx context.Context, input *slack.GetConversationInfoInput) (*ConversationInfo, error)
+	MockGetConversations           func(ctx context.Context, params *slack.GetConversationsParameters) ([]slack.Channel, string, error)
+	MockGetUsersInConversation     func(ctx context.Context, params *slack.GetUsersInConversationParameters) ([]string, string, error)
+	MockJoinConversation           func(ctx context.Context, channelID string) (*slack.Channel, string, []string, error)
+	MockInviteUsersToConversation  func(ctx context.Context, channelID string, users ...string) (*slack.Channel, error)
+	MockKickUserFromConversation   func(ctx context.Context, channelID, user string) error
+	MockSetTopicOfConversation     func(ctx context.Context, channelID, topic string) (*slack.Channel, error)
+	MockSetPurposeOfConversation   func(ctx context.Context, channelID, purpose string) (*slack.Channel, error)
+	MockInviteSharedToConversation func(ctx context.Context, params slack.InviteSharedToConversationParams) (string, bool, error)
+	MockListConnectInvites         func(ctx context.Context, cursor string) ([]ConnectInvite, string, error)
+	MockRenameConversation         func(ctx context.Context, channelID, name string) (*slack.Channel, error)
+	MockArchiveConversation        func(ctx context.Context, channelID string) error
+	MockUnArchiveConversation      func(ctx context.Context, channelID string) error
+
+	// Message mocks
+	MockPostMessage            func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error)
+	MockUpdateMessage          func(ctx context.Context, channelID, timestamp string, options ...slack.MsgOption) (string, string, string, error)
+	MockDeleteMessage          func(ctx context.Context, channelID, timestamp string) (string, string, error)
+	MockGetConversationHistory func(ctx context.Context, params *slack.GetConversationHistoryParameters) (*slack.GetConversationHistoryResponse, error)
+	MockGetPermalink           func(ctx context.Context, params *slack.PermalinkParameters) (string, error)
+
+	// Pin mocks
+	MockAddPin    func(ctx context.Context, channelID string, item slack.ItemRef) error
+	MockRemovePin func(ctx context.Context, channelID string, item slack.ItemRef) error
+	MockListPins  func(ctx context.Context, channelID string) ([]slack.Item, *slack.Paging, error)
+
+	// Canvas mocks
+	MockCreateChannelCanvas func(ctx context.Context, channelID string, content slack.DocumentContent) (string, error)
+	MockEditCanvas          func(ctx context.Context, params slack.EditCanvasParams) error
+	MockDeleteCanvas        func(ctx context.Context, canvasID string) error
+	MockGetCanvasContent    func(ctx context.Context, canvasID string) ([]byte, error)
+
+	// Admin conversation mocks
+	MockAdminConversationsGetConversationPrefs      func(ctx context.Context, channelID string) (*ConversationPrefs, error)
+	MockAdminConversationsSetConversationPrefs      func(ctx context.Context, channelID string, prefs map[string]string) error
+	MockAdminConversationsGetCustomRetention        func(ctx context.Context, channelID string) (int, bool, error)
+	MockAdminConversationsSetCustomRetention        func(ctx context.Context, channelID string, durationDays int) error
+	MockAdminConversationsRemoveCustomRetention     func(ctx context.Context, channelID string) error
+	MockAdminConversationsDisconnectShared          func(ctx context.Context, channelID string, leavingTeamIDs []string) error
+	MockAdminConversationsRestrictAccessAddGroup    func(ctx context.Context, channelID, groupID, teamID string) error
+	MockAdminConversationsRestrictAccessRemoveGroup func(ctx context.Context, channelID, groupID, teamID string) error
+	MockAdminConversationsRestrictAccessListGroups  func(ctx context.Context, channelID, teamID string) ([]string, error)
+	MockAdminConversationsCreate                    func(ctx context.Context, name string, isPrivate, orgWide bool, teamID string) (string, error)
+	MockAdminConversationsSetTeams                  func(ctx context.Context, params slack.AdminConversationsSetTeamsParams) error
+	MockAdminConversationsGetTeams                  func(ctx context.Context, channelID, cursor string) ([]string, string, error)
+	MockAdminConversationsRename                    func(ctx context.Context, channelID, name string) error
+	MockAdminConversationsConvertToPrivate          func(ctx context.Context, channelID string) error
+	MockAdminConversationsConvertToPublic           func(ctx context.Context, channelID string) error
+	MockAdminConversationsArchive                   func(ctx context.Context, channelID string) error
+	MockAdminConversationsDelete                    func(ctx context.Context, channelID string) error
 
 	// User group mocks
 	MockCreateUserGroup        func(ctx context.Context, userGroup slack.UserGroup, options ...slack.CreateUserGroupOption) (slack.UserGroup, error)
@@ -108,6 +188,19 @@ func (m *MockSlackClient) GetConversationInfoContext(ctx context.Context, input
 	return nil, nil
 }
 
+// GetConversationDetailsContext falls back to MockGetConversationInfo, so the
+// tests that don't check updated only mock conversations.info once.
+func (m *MockSlackClient) GetConversationDetailsContext(ctx context.Context, input *slack.GetConversationInfoInput) (*ConversationInfo, error) {
+	if m.MockGetConversationDetails != nil {
+		return m.MockGetConversationDetails(ctx, input)
+	}
+	channel, err := m.GetConversationInfoContext(ctx, input)
+	if channel == nil {
+		return nil, err
+	}
+	return &ConversationInfo{Channel: *channel}, err
+}
+
 func (m *MockSlackClient) GetConversationsContext(ctx context.Context, params *slack.GetConversationsParameters) ([]slack.Channel, string, error) {
 	if m.MockGetConversations != nil {
 		return m.MockGetConversations(ctx, params)
@@ -157,6 +250,20 @@ func (m *MockSlackClient) SetPurposeOfConversationContext(ctx context.Context, c
 	return nil, nil
 }
 
+func (m *MockSlackClient) InviteSharedToConversationContext(ctx context.Context, params slack.InviteSharedToConversationParams) (string, bool, error) {
+	if m.MockInviteSharedToConversation != nil {
+		return m.MockInviteSharedToConversation(ctx, params)
+	}
+	return "", false, nil
+}
+
+func (m *MockSlackClient) ListConnectInvitesContext(ctx context.Context, cursor string) ([]ConnectInvite, string, error) {
+	if m.MockListConnectInvites != nil {
+		return m.MockListConnectInvites(ctx, cursor)
+	}
+	return nil, "", nil
+}
+
 func (m *MockSlackClient) RenameConversationContext(ctx context.Context, channelID, name string) (*slack.Channel, error) {
 	if m.MockRenameConversation != nil {
 		return m.MockRenameConversation(ctx, channelID, name)
@@ -178,6 +285,213 @@ func (m *MockSlackClient) UnArchiveConversationContext(ctx context.Context, chan
 	return nil
 }
 
+// Message operations
+func (m *MockSlackClient) PostMessageContext(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+	if m.MockPostMessage != nil {
+		return m.MockPostMessage(ctx, channelID, options...)
+	}
+	return "", "", nil
+}
+
+func (m *MockSlackClient) UpdateMessageContext(ctx context.Context, channelID, timestamp string, options ...slack.MsgOption) (string, string, string, error) {
+	if m.MockUpdateMessage != nil {
+		return m.MockUpdateMessage(ctx, channelID, timestamp, options...)
+	}
+	return "", "", "", nil
+}
+
+func (m *MockSlackClient) DeleteMessageContext(ctx context.Context, channelID, timestamp string) (string, string, error) {
+	if m.MockDeleteMessage != nil {
+		return m.MockDeleteMessage(ctx, channelID, timestamp)
+	}
+	return "", "", nil
+}
+
+func (m *MockSlackClient) GetConversationHistoryContext(ctx context.Context, params *slack.GetConversationHistoryParameters) (*slack.GetConversationHistoryResponse, error) {
+	if m.MockGetConversationHistory != nil {
+		return m.MockGetConversationHistory(ctx, params)
+	}
+	return nil, nil
+}
+
+func (m *MockSlackClient) GetPermalinkContext(ctx context.Context, params *slack.PermalinkParameters) (string, error) {
+	if m.MockGetPermalink != nil {
+		return m.MockGetPermalink(ctx, params)
+	}
+	return "", nil
+}
+
+// Pin operations
+func (m *MockSlackClient) AddPinContext(ctx context.Context, channelID string, item slack.ItemRef) error {
+	if m.MockAddPin != nil {
+		return m.MockAddPin(ctx, channelID, item)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) RemovePinContext(ctx context.Context, channelID string, item slack.ItemRef) error {
+	if m.MockRemovePin != nil {
+		return m.MockRemovePin(ctx, channelID, item)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) ListPinsContext(ctx context.Context, channelID string) ([]slack.Item, *slack.Paging, error) {
+	if m.MockListPins != nil {
+		return m.MockListPins(ctx, channelID)
+	}
+	return nil, nil, nil
+}
+
+// Canvas operations
+func (m *MockSlackClient) CreateChannelCanvasContext(ctx context.Context, channelID string, content slack.DocumentContent) (string, error) {
+	if m.MockCreateChannelCanvas != nil {
+		return m.MockCreateChannelCanvas(ctx, channelID, content)
+	}
+	return "", nil
+}
+
+func (m *MockSlackClient) EditCanvasContext(ctx context.Context, params slack.EditCanvasParams) error {
+	if m.MockEditCanvas != nil {
+		return m.MockEditCanvas(ctx, params)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) DeleteCanvasContext(ctx context.Context, canvasID string) error {
+	if m.MockDeleteCanvas != nil {
+		return m.MockDeleteCanvas(ctx, canvasID)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) GetCanvasContentContext(ctx context.Context, canvasID string) ([]byte, error) {
+	if m.MockGetCanvasContent != nil {
+		return m.MockGetCanvasContent(ctx, canvasID)
+	}
+	return nil, nil
+}
+
+// Admin conversation operations
+func (m *MockSlackClient) AdminConversationsGetConversationPrefsContext(ctx context.Context, channelID string) (*ConversationPrefs, error) {
+	if m.MockAdminConversationsGetConversationPrefs != nil {
+		return m.MockAdminConversationsGetConversationPrefs(ctx, channelID)
+	}
+	return nil, nil
+}
+
+func (m *MockSlackClient) AdminConversationsSetConversationPrefsContext(ctx context.Context, channelID string, prefs map[string]string) error {
+	if m.MockAdminConversationsSetConversationPrefs != nil {
+		return m.MockAdminConversationsSetConversationPrefs(ctx, channelID, prefs)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) AdminConversationsGetCustomRetentionContext(ctx context.Context, channelID string) (int, bool, error) {
+	if m.MockAdminConversationsGetCustomRetention != nil {
+		return m.MockAdminConversationsGetCustomRetention(ctx, channelID)
+	}
+	return 0, false, nil
+}
+
+func (m *MockSlackClient) AdminConversationsSetCustomRetentionContext(ctx context.Context, channelID string, durationDays int) error {
+	if m.MockAdminConversationsSetCustomRetention != nil {
+		return m.MockAdminConversationsSetCustomRetention(ctx, channelID, durationDays)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) AdminConversationsRemoveCustomRetentionContext(ctx context.Context, channelID string) error {
+	if m.MockAdminConversationsRemoveCustomRetention != nil {
+		return m.MockAdminConversationsRemoveCustomRetention(ctx, channelID)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) AdminConversationsDisconnectSharedContext(ctx context.Context, channelID string, leavingTeamIDs []string) error {
+	if m.MockAdminConversationsDisconnectShared != nil {
+		return m.MockAdminConversationsDisconnectShared(ctx, channelID, leavingTeamIDs)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) AdminConversationsRestrictAccessAddGroupContext(ctx context.Context, channelID, groupID, teamID string) error {
+	if m.MockAdminConversationsRestrictAccessAddGroup != nil {
+		return m.MockAdminConversationsRestrictAccessAddGroup(ctx, channelID, groupID, teamID)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) AdminConversationsRestrictAccessRemoveGroupContext(ctx context.Context, channelID, groupID, teamID string) error {
+	if m.MockAdminConversationsRestrictAccessRemoveGroup != nil {
+		return m.MockAdminConversationsRestrictAccessRemoveGroup(ctx, channelID, groupID, teamID)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) AdminConversationsRestrictAccessListGroupsContext(ctx context.Context, channelID, teamID string) ([]string, error) {
+	if m.MockAdminConversationsRestrictAccessListGroups != nil {
+		return m.MockAdminConversationsRestrictAccessListGroups(ctx, channelID, teamID)
+	}
+	return nil, nil
+}
+
+func (m *MockSlackClient) AdminConversationsCreateContext(ctx context.Context, name string, isPrivate, orgWide bool, teamID string) (string, error) {
+	if m.MockAdminConversationsCreate != nil {
+		return m.MockAdminConversationsCreate(ctx, name, isPrivate, orgWide, teamID)
+	}
+	return "", nil
+}
+
+func (m *MockSlackClient) AdminConversationsSetTeamsContext(ctx context.Context, params slack.AdminConversationsSetTeamsParams) error {
+	if m.MockAdminConversationsSetTeams != nil {
+		return m.MockAdminConversationsSetTeams(ctx, params)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) AdminConversationsGetTeamsContext(ctx context.Context, channelID, cursor string) ([]string, string, error) {
+	if m.MockAdminConversationsGetTeams != nil {
+		return m.MockAdminConversationsGetTeams(ctx, channelID, cursor)
+	}
+	return nil, "", nil
+}
+
+func (m *MockSlackClient) AdminConversationsRenameContext(ctx context.Context, channelID, name string) error {
+	if m.MockAdminConversationsRename != nil {
+		return m.MockAdminConversationsRename(ctx, channelID, name)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) AdminConversationsConvertToPrivateContext(ctx context.Context, channelID string) error {
+	if m.MockAdminConversationsConvertToPrivate != nil {
+		return m.MockAdminConversationsConvertToPrivate(ctx, channelID)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) AdminConversationsConvertToPublicContext(ctx context.Context, channelID string) error {
+	if m.MockAdminConversationsConvertToPublic != nil {
+		return m.MockAdminConversationsConvertToPublic(ctx, channelID)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) AdminConversationsArchiveContext(ctx context.Context, channelID string) error {
+	if m.MockAdminConversationsArchive != nil {
+		return m.MockAdminConversationsArchive(ctx, channelID)
+	}
+	return nil
+}
+
+func (m *MockSlackClient) AdminConversationsDeleteContext(ctx context.Context, channelID string) error {
+	if m.MockAdminConversationsDelete != nil {
+		return m.MockAdminConversationsDelete(ctx, channelID)
+	}
+	return nil
+}
+
 // User group operations
 func (m *MockSlackClient) CreateUserGroupContext(ctx context.Context, userGroup slack.UserGroup, options ...slack.CreateUserGroupOption) (slack.UserGroup, error) {
 	if m.MockCreateUserGroup != nil {
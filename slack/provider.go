@@ -2,10 +2,14 @@ package slack
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/slack-go/slack"
 )
 
@@ -25,17 +29,34 @@ func Provider() *schema.Provider {
 				Default:     DefaultRetryTimeoutSeconds,
 				Description: "The timeout in seconds for retry operations when rate limited by Slack. Defaults to 60 seconds.",
 			},
+			"max_member_removals": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of members a conversation can kick in a single apply. Defaults to 0, which is unlimited.",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"slack_conversation": resourceSlackConversation(),
-			"slack_usergroup":    resourceSlackUserGroup(),
+			"slack_admin_conversation":          resourceSlackAdminConversation(),
+			"slack_chat_message":                resourceSlackChatMessage(),
+			"slack_conversation":                resourceSlackConversation(),
+			"slack_conversation_canvas":         resourceSlackConversationCanvas(),
+			"slack_conversation_connect_invite": resourceSlackConversationConnectInvite(),
+			"slack_conversation_idp_groups":     resourceSlackConversationIDPGroups(),
+			"slack_conversation_prefs":          resourceSlackConversationPrefs(),
+			"slack_pin":                         resourceSlackPin(),
+			"slack_usergroup":                   resourceSlackUserGroup(),
+			"slack_usergroup_member":            resourceSlackUserGroupMember(),
+			"slack_usergroup_members":           resourceSlackUserGroupMembers(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"slack_conversation": dataSourceConversation(),
-			"slack_user":         dataSourceUser(),
-			"slack_usergroup":    dataSourceUserGroup(),
+			"slack_conversation":  dataSourceConversation(),
+			"slack_conversations": dataSourceConversations(),
+			"slack_user":          dataSourceUser(),
+			"slack_usergroup":     dataSourceUserGroup(),
 		},
 
 		ConfigureContextFunc: providerConfigure,
@@ -44,8 +65,28 @@ func Provider() *schema.Provider {
 
 // ProviderConfig holds the provider configuration
 type ProviderConfig struct {
-	Client      ClientInterface
-	RetryConfig *RetryConfig
+	Client            ClientInterface
+	RetryConfig       *RetryConfig
+	MaxMemberRemovals int
+
+	authMutex sync.Mutex
+	authInfo  *slack.AuthTestResponse
+}
+
+// authTest returns the identity of the token. auth.test is only called once
+// for all the resources of the provider.
+func (c *ProviderConfig) authTest(ctx context.Context, retryConfig *RetryConfig) (*slack.AuthTestResponse, error) {
+	c.authMutex.Lock()
+	defer c.authMutex.Unlock()
+	if c.authInfo != nil {
+		return c.authInfo, nil
+	}
+	authInfo, err := WithRetryWithResult(ctx, retryConfig, c.Client.AuthTest)
+	if err != nil {
+		return nil, err
+	}
+	c.authInfo = authInfo
+	return authInfo, nil
 }
 
 func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -62,11 +103,12 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 	}
 
 	slackClient := slack.New(token.(string))
-	wrappedClient := NewClientWrapper(slackClient)
+	wrappedClient := NewClientWrapper(slackClient, token.(string))
 
 	config := &ProviderConfig{
-		Client:      wrappedClient,
-		RetryConfig: retryConfig,
+		Client:            wrappedClient,
+		RetryConfig:       retryConfig,
+		MaxMemberRemovals: d.Get("max_member_removals").(int),
 	}
 
 	return config, diags
@@ -83,6 +125,32 @@ func schemaSetToSlice(set *schema.Set) []string {
 	return s
 }
 
+// compositeID builds the ID of a resource that is addressed by two Slack IDs,
+// e.g. a conversation and a message timestamp.
+func compositeID(first, second string) string {
+	return first + "/" + second
+}
+
+// parseCompositeID splits an ID built by compositeID. format describes the
+// expected ID in errors, e.g. <channel_id>/<ts>.
+func parseCompositeID(id, format string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected ID %q, expected %s", id, format)
+	}
+	return parts[0], parts[1], nil
+}
+
+// conversationTimestampID builds the ID of a resource that is addressed by a
+// conversation and a message timestamp.
+func conversationTimestampID(channelID, ts string) string {
+	return compositeID(channelID, ts)
+}
+
+func parseConversationTimestampID(id string) (string, string, error) {
+	return parseCompositeID(id, "<channel_id>/<ts>")
+}
+
 func remove(s []string, r string) []string {
 	result := make([]string, 0, len(s))
 	for _, v := range s {
@@ -1,10 +1,18 @@
 package slack
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type testUser struct {
@@ -60,6 +68,16 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderMaxMemberRemovalsValidation(t *testing.T) {
+	for value, valid := range map[int]bool{-1: false, 0: true, 5: true} {
+		diags := Provider().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+			"token":               "xoxp-test",
+			"max_member_removals": value,
+		}))
+		assert.Equal(t, !valid, diags.HasError(), "max_member_removals = %d", value)
+	}
+}
+
 func TestProvider_impl(_ *testing.T) {
 	var _ = Provider()
 }
@@ -69,3 +87,39 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("SLACK_TOKEN must be set for acceptance tests")
 	}
 }
+
+// testResourceApply plans raw against state and applies the resulting diff
+// through the CRUD functions of the resource, the same way terraform apply does.
+func testResourceApply(t *testing.T, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, meta interface{}) (*terraform.InstanceState, diag.Diagnostics) {
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), meta)
+	require.NoError(t, err)
+	return r.Apply(context.Background(), state, diff, meta)
+}
+
+// testSetStateAttribute flattens a set of strings into state attributes.
+func testSetStateAttribute(state *terraform.InstanceState, key string, values []string) {
+	state.Attributes[key+".#"] = strconv.Itoa(len(values))
+	for _, v := range values {
+		state.Attributes[fmt.Sprintf("%s.%d", key, schema.HashString(v))] = v
+	}
+}
+
+func TestProviderConfigAuthTest(t *testing.T) {
+	calls := 0
+	config := &ProviderConfig{
+		Client: &MockSlackClient{
+			MockAuthTest: func() (*slack.AuthTestResponse, error) {
+				calls++
+				return &slack.AuthTestResponse{UserID: "UAPI"}, nil
+			},
+		},
+		RetryConfig: DefaultRetryConfig(),
+	}
+
+	for i := 0; i < 2; i++ {
+		authInfo, err := config.authTest(context.Background(), config.RetryConfig)
+		require.NoError(t, err)
+		assert.Equal(t, "UAPI", authInfo.UserID)
+	}
+	assert.Equal(t, 1, calls)
+}
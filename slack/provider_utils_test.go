@@ -148,3 +148,43 @@ func TestContains(t *testing.T) {
 		})
 	}
 }
+
+func TestParseConversationTimestampID(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		expectedCh  string
+		expectedTs  string
+		expectError bool
+	}{
+		{
+			name:       "valid ID",
+			id:         conversationTimestampID("C123", "1700000000.000100"),
+			expectedCh: "C123",
+			expectedTs: "1700000000.000100",
+		},
+		{
+			name:        "missing timestamp",
+			id:          "C123",
+			expectError: true,
+		},
+		{
+			name:        "empty channel",
+			id:          "/1700000000.000100",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channelID, ts, err := parseConversationTimestampID(tt.id)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCh, channelID)
+			assert.Equal(t, tt.expectedTs, ts)
+		})
+	}
+}
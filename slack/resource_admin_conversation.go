@@ -0,0 +1,287 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+)
+
+const adminConversationImportIDFormat = "<channel_id> or <channel_id>/org_wide"
+
+func resourceSlackAdminConversation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackAdminConversationRead,
+		CreateContext: resourceSlackAdminConversationCreate,
+		UpdateContext: resourceSlackAdminConversationUpdate,
+		DeleteContext: resourceSlackAdminConversationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSlackAdminConversationImport,
+		},
+
+		CustomizeDiff: resourceSlackAdminConversationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"is_private": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"org_wide": {
+				Type:        schema.TypeBool,
+				Description: "Share the channel with every workspace of the organization",
+				Optional:    true,
+			},
+			"team_ids": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "The workspaces the channel is shared with",
+				Optional:    true,
+			},
+			"created": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"creator": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_archived": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSlackAdminConversationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	name := d.Get("name").(string)
+	isPrivate := d.Get("is_private").(bool)
+	orgWide := d.Get("org_wide").(bool)
+	teamIDs := schemaSetToSlice(d.Get("team_ids").(*schema.Set))
+	sort.Strings(teamIDs)
+
+	// admin.conversations.create only accepts a single workspace, the others
+	// are connected afterwards with admin.conversations.setTeams
+	var teamID string
+	if !orgWide && len(teamIDs) > 0 {
+		teamID = teamIDs[0]
+	}
+
+	channelID, err := client.AdminConversationsCreateContext(ctx, name, isPrivate, orgWide, teamID)
+	if err != nil {
+		return diag.Errorf("could not create conversation %s: %s", name, err)
+	}
+	d.SetId(channelID)
+
+	if len(teamIDs) > 1 {
+		err := client.AdminConversationsSetTeamsContext(ctx, slack.AdminConversationsSetTeamsParams{
+			ChannelID:     channelID,
+			TargetTeamIDs: teamIDs,
+			TeamID:        &teamID,
+		})
+		if err != nil {
+			return diag.Errorf("couldn't set workspaces of conversation %s: %s", channelID, err)
+		}
+	}
+
+	return resourceSlackAdminConversationRead(ctx, d, m)
+}
+
+func resourceSlackAdminConversationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+	id := d.Id()
+	var diags diag.Diagnostics
+
+	teamIDs, err := WithRetryWithResult(ctx, config.RetryConfig, func() ([]string, error) {
+		return getAdminConversationTeams(ctx, client, id)
+	})
+	if err != nil {
+		if err.Error() == "channel_not_found" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("channel with ID %s not found, removing from state", id),
+			})
+			d.SetId("")
+			return diags
+		}
+		return diag.Errorf("couldn't get workspaces of conversation %s: %s", id, err)
+	}
+
+	// an org wide channel is connected to every workspace, which is not
+	// something the configuration lists
+	if !d.Get("org_wide").(bool) {
+		if err := d.Set("team_ids", teamIDs); err != nil {
+			return diag.Errorf("error setting team_ids: %s", err)
+		}
+	}
+
+	// admins can't always read the details of private channels they aren't a member of
+	channel, err := WithRetryWithResult(ctx, config.RetryConfig, func() (*slack.Channel, error) {
+		return client.GetConversationInfoContext(ctx, &slack.GetConversationInfoInput{
+			ChannelID: id,
+		})
+	})
+	if err != nil {
+		tflog.Debug(ctx, "couldn't get conversation info, keeping name and privacy from state",
+			map[string]interface{}{"channel": id, "err": err.Error()})
+		return diags
+	}
+
+	if err := d.Set("name", channel.Name); err != nil {
+		return diag.Errorf("error setting name: %s", err)
+	}
+
+	if err := d.Set("is_private", channel.IsPrivate); err != nil {
+		return diag.Errorf("error setting is_private: %s", err)
+	}
+
+	if err := d.Set("is_archived", channel.IsArchived); err != nil {
+		return diag.Errorf("error setting is_archived: %s", err)
+	}
+
+	if err := d.Set("created", channel.Created); err != nil {
+		return diag.Errorf("error setting created: %s", err)
+	}
+
+	if err := d.Set("creator", channel.Creator); err != nil {
+		return diag.Errorf("error setting creator: %s", err)
+	}
+
+	return diags
+}
+
+func resourceSlackAdminConversationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	id := d.Id()
+
+	if d.HasChange("name") {
+		if err := client.AdminConversationsRenameContext(ctx, id, d.Get("name").(string)); err != nil {
+			return diag.Errorf("couldn't rename conversation: %s", err)
+		}
+	}
+
+	if d.HasChange("is_private") {
+		if d.Get("is_private").(bool) {
+			if err := client.AdminConversationsConvertToPrivateContext(ctx, id); err != nil {
+				return diag.Errorf("couldn't convert conversation %s to private: %s", id, err)
+			}
+		} else {
+			if err := client.AdminConversationsConvertToPublicContext(ctx, id); err != nil {
+				return diag.Errorf("couldn't convert conversation %s to public: %s", id, err)
+			}
+		}
+	}
+
+	if d.HasChanges("org_wide", "team_ids") {
+		orgWide := d.Get("org_wide").(bool)
+		params := slack.AdminConversationsSetTeamsParams{
+			ChannelID:  id,
+			OrgChannel: &orgWide,
+		}
+		if !orgWide {
+			params.TargetTeamIDs = schemaSetToSlice(d.Get("team_ids").(*schema.Set))
+		}
+		// setTeams expects the workspace of a channel that isn't shared yet,
+		// like after admin.conversations.create
+		oldOrgWide, _ := d.GetChange("org_wide")
+		oldTeamIDs, _ := d.GetChange("team_ids")
+		if teamIDs := schemaSetToSlice(oldTeamIDs.(*schema.Set)); !oldOrgWide.(bool) && len(teamIDs) == 1 {
+			params.TeamID = &teamIDs[0]
+		}
+		if err := client.AdminConversationsSetTeamsContext(ctx, params); err != nil {
+			return diag.Errorf("couldn't set workspaces of conversation %s: %s", id, err)
+		}
+	}
+
+	return resourceSlackAdminConversationRead(ctx, d, m)
+}
+
+func resourceSlackAdminConversationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	id := d.Id()
+	if err := client.AdminConversationsArchiveContext(ctx, id); err != nil {
+		if err.Error() == "channel_not_found" || err.Error() == "already_archived" {
+			return diags
+		}
+		return diag.Errorf("couldn't archive conversation %s: %s", id, err)
+	}
+
+	return diags
+}
+
+// resourceSlackAdminConversationCustomizeDiff checks that the channel is either
+// org wide or shared with a list of workspaces. It isn't ExactlyOneOf so that
+// org_wide = false can be set explicitly with team_ids.
+func resourceSlackAdminConversationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("org_wide") || !d.NewValueKnown("team_ids") {
+		return nil
+	}
+	orgWide := d.Get("org_wide").(bool)
+	teamIDs := d.Get("team_ids").(*schema.Set).Len()
+	switch {
+	case orgWide && teamIDs > 0:
+		return fmt.Errorf("team_ids can't be set when org_wide is true")
+	case !orgWide && teamIDs == 0:
+		return fmt.Errorf("one of org_wide or team_ids must be set")
+	}
+	return nil
+}
+
+// resourceSlackAdminConversationImport imports a channel shared with a list of
+// workspaces by its ID, and an org wide channel with the /org_wide suffix,
+// since admin.conversations.getTeams doesn't tell them apart.
+func resourceSlackAdminConversationImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if !strings.Contains(d.Id(), "/") {
+		return []*schema.ResourceData{d}, nil
+	}
+	channelID, mode, err := parseCompositeID(d.Id(), adminConversationImportIDFormat)
+	if err != nil {
+		return nil, err
+	}
+	if mode != "org_wide" {
+		return nil, fmt.Errorf("unexpected ID %q, expected %s", d.Id(), adminConversationImportIDFormat)
+	}
+	d.SetId(channelID)
+	if err := d.Set("org_wide", true); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func getAdminConversationTeams(ctx context.Context, client ClientInterface, channelID string) ([]string, error) {
+	var teamIDs []string
+	cursor := ""
+	for {
+		page, nextCursor, err := client.AdminConversationsGetTeamsContext(ctx, channelID, cursor)
+		if err != nil {
+			return nil, err
+		}
+		teamIDs = append(teamIDs, page...)
+		if nextCursor == "" {
+			return teamIDs, nil
+		}
+		cursor = nextCursor
+	}
+}
@@ -0,0 +1,85 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/slack-go/slack"
+)
+
+func TestAccSlackAdminConversationTest(t *testing.T) {
+	resourceName := "slack_admin_conversation.test"
+	name := acctest.RandomWithPrefix(conversationNamePrefix)
+	// admin.conversations methods are only available to Enterprise Grid
+	// organizations
+	teamID := os.Getenv("SLACK_ENTERPRISE_TEAM_ID")
+
+	var providers []*schema.Provider
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if teamID == "" {
+				t.Skip("SLACK_ENTERPRISE_TEAM_ID must be set to test admin conversations")
+			}
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAdminConversationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackAdminConversationConfig(name, teamID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "is_private", "true"),
+					resource.TestCheckResourceAttr(resourceName, "team_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "team_ids.*", teamID),
+					resource.TestCheckResourceAttr(resourceName, "is_archived", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAdminConversationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderConfig).Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "slack_admin_conversation" {
+			continue
+		}
+
+		channel, err := client.GetConversationInfoContext(context.Background(), &slack.GetConversationInfoInput{
+			ChannelID: rs.Primary.ID,
+		})
+		if err != nil {
+			if err.Error() == "channel_not_found" {
+				continue
+			}
+			return fmt.Errorf("error getting conversation %s: %s", rs.Primary.ID, err)
+		}
+		if !channel.IsArchived {
+			return fmt.Errorf("conversation %s is not archived", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccSlackAdminConversationConfig(name, teamID string) string {
+	return fmt.Sprintf(`
+resource slack_admin_conversation test {
+  name       = "%s"
+  is_private = true
+  team_ids   = ["%s"]
+}
+`, name, teamID)
+}
@@ -0,0 +1,269 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceSlackAdminConversationCreate(t *testing.T) {
+	tests := []struct {
+		name            string
+		data            map[string]interface{}
+		expectedTeamID  string
+		expectedOrgWide bool
+		expectedSetTeam []string
+	}{
+		{
+			name: "org wide channel",
+			data: map[string]interface{}{
+				"name":       "announcements",
+				"is_private": false,
+				"org_wide":   true,
+			},
+			expectedOrgWide: true,
+		},
+		{
+			name: "single workspace",
+			data: map[string]interface{}{
+				"name":       "project",
+				"is_private": true,
+				"team_ids":   []interface{}{"T001"},
+			},
+			expectedTeamID: "T001",
+		},
+		{
+			name: "multiple workspaces",
+			data: map[string]interface{}{
+				"name":       "project",
+				"is_private": false,
+				"team_ids":   []interface{}{"T002", "T001"},
+			},
+			expectedTeamID:  "T001",
+			expectedSetTeam: []string{"T001", "T002"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				createdTeamID  string
+				createdOrgWide bool
+				setTeams       []string
+			)
+			mockClient := &MockSlackClient{
+				MockAdminConversationsCreate: func(_ context.Context, _ string, _ bool, orgWide bool, teamID string) (string, error) {
+					createdOrgWide = orgWide
+					createdTeamID = teamID
+					return "C123", nil
+				},
+				MockAdminConversationsSetTeams: func(_ context.Context, params slack.AdminConversationsSetTeamsParams) error {
+					setTeams = params.TargetTeamIDs
+					return nil
+				},
+				MockAdminConversationsGetTeams: func(_ context.Context, _, _ string) ([]string, string, error) {
+					return []string{"T001"}, "", nil
+				},
+				MockGetConversationInfo: func(_ context.Context, _ *slack.GetConversationInfoInput) (*slack.Channel, error) {
+					return nil, errors.New("channel_not_found")
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackAdminConversation().Schema, tt.data)
+
+			diags := resourceSlackAdminConversationCreate(context.Background(), resourceData, config)
+
+			assert.Empty(t, diags)
+			assert.Equal(t, "C123", resourceData.Id())
+			assert.Equal(t, tt.expectedOrgWide, createdOrgWide)
+			assert.Equal(t, tt.expectedTeamID, createdTeamID)
+			assert.Equal(t, tt.expectedSetTeam, setTeams)
+		})
+	}
+}
+
+func TestGetAdminConversationTeams(t *testing.T) {
+	mockClient := &MockSlackClient{
+		MockAdminConversationsGetTeams: func(_ context.Context, _, cursor string) ([]string, string, error) {
+			if cursor == "" {
+				return []string{"T001", "T002"}, "next", nil
+			}
+			return []string{"T003"}, "", nil
+		},
+	}
+
+	teamIDs, err := getAdminConversationTeams(context.Background(), mockClient, "C123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"T001", "T002", "T003"}, teamIDs)
+}
+
+func TestResourceSlackAdminConversationCustomizeDiff(t *testing.T) {
+	tests := []struct {
+		name          string
+		raw           map[string]interface{}
+		expectedError string
+	}{
+		{
+			name: "org wide",
+			raw:  map[string]interface{}{"name": "announcements", "is_private": false, "org_wide": true},
+		},
+		{
+			name: "team_ids with org_wide false",
+			raw:  map[string]interface{}{"name": "project", "is_private": false, "org_wide": false, "team_ids": []interface{}{"T001"}},
+		},
+		{
+			name:          "org wide with team_ids",
+			raw:           map[string]interface{}{"name": "project", "is_private": false, "org_wide": true, "team_ids": []interface{}{"T001"}},
+			expectedError: "team_ids can't be set when org_wide is true",
+		},
+		{
+			name:          "neither",
+			raw:           map[string]interface{}{"name": "project", "is_private": false},
+			expectedError: "one of org_wide or team_ids must be set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := resourceSlackAdminConversation().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tt.raw), &ProviderConfig{})
+
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestResourceSlackAdminConversationUpdate_SetTeams(t *testing.T) {
+	tests := []struct {
+		name            string
+		stateTeamIDs    []string
+		stateOrgWide    bool
+		raw             map[string]interface{}
+		expectedTeamID  string
+		expectedTargets []string
+	}{
+		{
+			name:            "shared from a single workspace",
+			stateTeamIDs:    []string{"T001"},
+			raw:             map[string]interface{}{"name": "project", "is_private": false, "team_ids": []interface{}{"T001", "T002"}},
+			expectedTeamID:  "T001",
+			expectedTargets: []string{"T001", "T002"},
+		},
+		{
+			name:            "already shared with several workspaces",
+			stateTeamIDs:    []string{"T001", "T002"},
+			raw:             map[string]interface{}{"name": "project", "is_private": false, "team_ids": []interface{}{"T001", "T002", "T003"}},
+			expectedTargets: []string{"T001", "T002", "T003"},
+		},
+		{
+			name:            "org wide to workspaces",
+			stateOrgWide:    true,
+			raw:             map[string]interface{}{"name": "project", "is_private": false, "org_wide": false, "team_ids": []interface{}{"T001"}},
+			expectedTargets: []string{"T001"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var params *slack.AdminConversationsSetTeamsParams
+			mockClient := &MockSlackClient{
+				MockAdminConversationsSetTeams: func(_ context.Context, p slack.AdminConversationsSetTeamsParams) error {
+					params = &p
+					return nil
+				},
+				MockAdminConversationsGetTeams: func(_ context.Context, _, _ string) ([]string, string, error) {
+					return nil, "", nil
+				},
+				MockGetConversationInfo: func(_ context.Context, _ *slack.GetConversationInfoInput) (*slack.Channel, error) {
+					return nil, errors.New("channel_not_found")
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+			state := &terraform.InstanceState{
+				ID: "C123",
+				Attributes: map[string]string{
+					"id":         "C123",
+					"name":       "project",
+					"is_private": "false",
+					"org_wide":   strconv.FormatBool(tt.stateOrgWide),
+				},
+			}
+			testSetStateAttribute(state, "team_ids", tt.stateTeamIDs)
+
+			_, diags := testResourceApply(t, resourceSlackAdminConversation(), state, tt.raw, config)
+
+			assert.Empty(t, diags)
+			require.NotNil(t, params)
+			if tt.expectedTeamID == "" {
+				assert.Nil(t, params.TeamID)
+			} else {
+				require.NotNil(t, params.TeamID)
+				assert.Equal(t, tt.expectedTeamID, *params.TeamID)
+			}
+			assert.ElementsMatch(t, tt.expectedTargets, params.TargetTeamIDs)
+			assert.False(t, *params.OrgChannel)
+		})
+	}
+}
+
+func TestResourceSlackAdminConversationImport(t *testing.T) {
+	tests := []struct {
+		name            string
+		id              string
+		expectedID      string
+		expectedOrgWide bool
+		expectedError   string
+	}{
+		{
+			name:       "workspaces",
+			id:         "C123",
+			expectedID: "C123",
+		},
+		{
+			name:            "org wide",
+			id:              "C123/org_wide",
+			expectedID:      "C123",
+			expectedOrgWide: true,
+		},
+		{
+			name:          "unknown mode",
+			id:            "C123/T001",
+			expectedError: `unexpected ID "C123/T001"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resourceData := resourceSlackAdminConversation().Data(&terraform.InstanceState{ID: tt.id})
+
+			result, err := resourceSlackAdminConversationImport(context.Background(), resourceData, &ProviderConfig{})
+
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, result, 1)
+			assert.Equal(t, tt.expectedID, result[0].Id())
+			assert.Equal(t, tt.expectedOrgWide, result[0].Get("org_wide"))
+		})
+	}
+}
@@ -0,0 +1,314 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/slack-go/slack"
+)
+
+func resourceSlackChatMessage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackChatMessageRead,
+		CreateContext: resourceSlackChatMessageCreate,
+		UpdateContext: resourceSlackChatMessageUpdate,
+		DeleteContext: resourceSlackChatMessageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSlackChatMessageImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"text": {
+				Type:             schema.TypeString,
+				Description:      "Text of the message, used as the notification fallback when blocks are set",
+				Optional:         true,
+				AtLeastOneOf:     []string{"text", "blocks"},
+				DiffSuppressFunc: suppressChatMessageTextDiff,
+			},
+			"blocks": {
+				Type:             schema.TypeString,
+				Description:      "JSON encoded array of Block Kit blocks",
+				Optional:         true,
+				AtLeastOneOf:     []string{"text", "blocks"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressChatMessageBlocksDiff,
+			},
+			"ts": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"permalink": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func chatMessageOptions(d *schema.ResourceData) ([]slack.MsgOption, error) {
+	var options []slack.MsgOption
+
+	if text := d.Get("text").(string); text != "" {
+		options = append(options, slack.MsgOptionText(text, false))
+	}
+
+	if raw := d.Get("blocks").(string); raw != "" {
+		var blocks slack.Blocks
+		if err := json.Unmarshal([]byte(raw), &blocks); err != nil {
+			return nil, fmt.Errorf("couldn't parse blocks: %s", err)
+		}
+		options = append(options, slack.MsgOptionBlocks(blocks.BlockSet...))
+	}
+
+	return options, nil
+}
+
+func resourceSlackChatMessageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	channelID := d.Get("channel_id").(string)
+	options, err := chatMessageOptions(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, ts, err := client.PostMessageContext(ctx, channelID, options...)
+	if err != nil {
+		return diag.Errorf("could not post message to conversation %s: %s", channelID, err)
+	}
+	d.SetId(conversationTimestampID(channelID, ts))
+
+	return resourceSlackChatMessageRead(ctx, d, m)
+}
+
+func resourceSlackChatMessageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+	id := d.Id()
+	var diags diag.Diagnostics
+
+	channelID, ts, err := parseConversationTimestampID(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	history, err := WithRetryWithResult(ctx, config.RetryConfig, func() (*slack.GetConversationHistoryResponse, error) {
+		return client.GetConversationHistoryContext(ctx, &slack.GetConversationHistoryParameters{
+			ChannelID: channelID,
+			Latest:    ts,
+			Oldest:    ts,
+			Inclusive: true,
+			Limit:     1,
+		})
+	})
+	if err != nil && err.Error() != "channel_not_found" {
+		return diag.Errorf("couldn't get message %s: %s", id, err)
+	}
+	message := findHistoryMessage(history, ts)
+	if err != nil || message == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("message with ID %s not found, removing from state", id),
+		})
+		d.SetId("")
+		return diags
+	}
+
+	permalink, err := WithRetryWithResult(ctx, config.RetryConfig, func() (string, error) {
+		return client.GetPermalinkContext(ctx, &slack.PermalinkParameters{
+			Channel: channelID,
+			Ts:      ts,
+		})
+	})
+	if err != nil {
+		return diag.Errorf("couldn't get permalink of message %s: %s", id, err)
+	}
+
+	if err := d.Set("channel_id", channelID); err != nil {
+		return diag.Errorf("error setting channel_id: %s", err)
+	}
+
+	if err := d.Set("ts", ts); err != nil {
+		return diag.Errorf("error setting ts: %s", err)
+	}
+
+	if err := d.Set("permalink", permalink); err != nil {
+		return diag.Errorf("error setting permalink: %s", err)
+	}
+
+	// messages posted with blocks only get a fallback text and messages posted
+	// with text only can get generated blocks, so only the configured
+	// attributes are refreshed, unless the message is being imported
+	imported := d.Get("text").(string) == "" && d.Get("blocks").(string) == ""
+
+	if imported || d.Get("text").(string) != "" {
+		if err := d.Set("text", message.Text); err != nil {
+			return diag.Errorf("error setting text: %s", err)
+		}
+	}
+
+	if imported || d.Get("blocks").(string) != "" {
+		blocks, err := chatMessageBlocks(message)
+		if err != nil {
+			return diag.Errorf("couldn't encode blocks of message %s: %s", id, err)
+		}
+		if err := d.Set("blocks", blocks); err != nil {
+			return diag.Errorf("error setting blocks: %s", err)
+		}
+	}
+
+	return diags
+}
+
+func resourceSlackChatMessageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	channelID, ts, err := parseConversationTimestampID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("text", "blocks") {
+		options, err := chatMessageOptions(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// chat.update keeps the blocks of the message when none are sent
+		if d.Get("blocks").(string) == "" {
+			options = append(options, slack.MsgOptionBlocks([]slack.Block{}...))
+		}
+		if _, _, _, err := client.UpdateMessageContext(ctx, channelID, ts, options...); err != nil {
+			return diag.Errorf("couldn't update message %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSlackChatMessageRead(ctx, d, m)
+}
+
+func resourceSlackChatMessageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	channelID, ts, err := parseConversationTimestampID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, _, err := client.DeleteMessageContext(ctx, channelID, ts); err != nil {
+		if err.Error() == "message_not_found" || err.Error() == "channel_not_found" {
+			return diags
+		}
+		return diag.Errorf("couldn't delete message %s: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceSlackChatMessageImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseConversationTimestampID(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func findHistoryMessage(history *slack.GetConversationHistoryResponse, ts string) *slack.Message {
+	if history == nil {
+		return nil
+	}
+	for i := range history.Messages {
+		if history.Messages[i].Timestamp == ts {
+			return &history.Messages[i]
+		}
+	}
+	return nil
+}
+
+// chatMessageBlocks encodes the blocks of a message as JSON
+func chatMessageBlocks(message *slack.Message) (string, error) {
+	if len(message.Blocks.BlockSet) == 0 {
+		return "", nil
+	}
+	raw, err := json.Marshal(message.Blocks)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// chatMessageLinkRegexp matches the links without a label that Slack wraps
+// bare URLs in, e.g. <https://example.com> or <mailto:oncall@example.com>
+var chatMessageLinkRegexp = regexp.MustCompile(`<([a-zA-Z][a-zA-Z0-9+.-]*:[^<>|\s]*)>`)
+
+var chatMessageTextUnescaper = strings.NewReplacer("&lt;", "<", "&gt;", ">", "&amp;", "&")
+
+// normalizeChatMessageText reverts the formatting Slack applies to the text
+// of a message: &, < and > are escaped and bare URLs are wrapped in <>.
+func normalizeChatMessageText(text string) string {
+	return chatMessageTextUnescaper.Replace(chatMessageLinkRegexp.ReplaceAllString(text, "$1"))
+}
+
+func suppressChatMessageTextDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return normalizeChatMessageText(old) == normalizeChatMessageText(new)
+}
+
+// chatMessageDefaultedBlockFields are set by Slack or slack-go on blocks that
+// are posted without them
+var chatMessageDefaultedBlockFields = []string{"block_id", "emoji"}
+
+// suppressChatMessageBlocksDiff compares the blocks read from Slack (old) with
+// the configured ones (new), ignoring the fields Slack fills in.
+func suppressChatMessageBlocksDiff(_, old, new string, _ *schema.ResourceData) bool {
+	var oldBlocks, newBlocks interface{}
+	if err := json.Unmarshal([]byte(old), &oldBlocks); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newBlocks); err != nil {
+		return false
+	}
+	dropDefaultedBlockFields(oldBlocks, newBlocks)
+	return reflect.DeepEqual(oldBlocks, newBlocks)
+}
+
+// dropDefaultedBlockFields removes from read the defaulted fields that
+// configured doesn't set, at any depth.
+func dropDefaultedBlockFields(read, configured interface{}) {
+	switch r := read.(type) {
+	case map[string]interface{}:
+		c, ok := configured.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for _, field := range chatMessageDefaultedBlockFields {
+			if _, ok := c[field]; !ok {
+				delete(r, field)
+			}
+		}
+		for key, value := range r {
+			dropDefaultedBlockFields(value, c[key])
+		}
+	case []interface{}:
+		c, ok := configured.([]interface{})
+		if !ok || len(c) != len(r) {
+			return
+		}
+		for i := range r {
+			dropDefaultedBlockFields(r[i], c[i])
+		}
+	}
+}
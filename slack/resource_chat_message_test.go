@@ -0,0 +1,55 @@
+package slack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccSlackChatMessageTest(t *testing.T) {
+	resourceName := "slack_chat_message.test"
+	name := acctest.RandomWithPrefix(conversationNamePrefix)
+
+	var providers []*schema.Provider
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckConversationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChatMessageConfig(name, "Deploying"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", "slack_conversation.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "text", "Deploying"),
+					resource.TestCheckResourceAttrSet(resourceName, "ts"),
+					resource.TestCheckResourceAttrSet(resourceName, "permalink"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Slack can add blocks to messages posted with text only
+				ImportStateVerifyIgnore: []string{"blocks"},
+			},
+			{
+				Config: testAccSlackChatMessageConfig(name, "Deployed"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "text", "Deployed"),
+			},
+		},
+	})
+}
+
+func testAccSlackChatMessageConfig(name, text string) string {
+	return testAccSlackConversationDependencyConfig(name) + fmt.Sprintf(`
+resource slack_chat_message test {
+  channel_id = slack_conversation.test.id
+  text       = "%s"
+}
+`, text)
+}
@@ -0,0 +1,307 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceSlackChatMessageCreate(t *testing.T) {
+	var postedChannel string
+	mockClient := &MockSlackClient{
+		MockPostMessage: func(_ context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+			postedChannel = channelID
+			assert.Len(t, options, 2)
+			return channelID, "1700000000.000100", nil
+		},
+		MockGetConversationHistory: func(_ context.Context, params *slack.GetConversationHistoryParameters) (*slack.GetConversationHistoryResponse, error) {
+			return &slack.GetConversationHistoryResponse{
+				Messages: []slack.Message{{Msg: slack.Msg{Timestamp: params.Latest}}},
+			}, nil
+		},
+		MockGetPermalink: func(_ context.Context, params *slack.PermalinkParameters) (string, error) {
+			return "https://example.slack.com/archives/" + params.Channel + "/p1700000000000100", nil
+		},
+	}
+	config := &ProviderConfig{
+		Client:      mockClient,
+		RetryConfig: DefaultRetryConfig(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSlackChatMessage().Schema, map[string]interface{}{
+		"channel_id": "C123",
+		"text":       "Deploying",
+		"blocks":     `[{"type":"section","text":{"type":"mrkdwn","text":"*Deploying*"}}]`,
+	})
+
+	diags := resourceSlackChatMessageCreate(context.Background(), resourceData, config)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "C123", postedChannel)
+	assert.Equal(t, "C123/1700000000.000100", resourceData.Id())
+	assert.Equal(t, "1700000000.000100", resourceData.Get("ts"))
+	assert.Equal(t, "https://example.slack.com/archives/C123/p1700000000000100", resourceData.Get("permalink"))
+}
+
+func TestResourceSlackChatMessageRead(t *testing.T) {
+	deployingBlock := slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, "Deploying", false, false), nil, nil, slack.SectionBlockOptionBlockID("generated"))
+	deployingBlocks := `[{"type":"section","text":{"type":"mrkdwn","text":"Deploying"},"block_id":"generated"}]`
+
+	tests := []struct {
+		name           string
+		data           map[string]interface{}
+		messages       []slack.Message
+		historyErr     error
+		expectedID     string
+		expectedText   string
+		expectedBlocks string
+		expectWarning  bool
+		expectError    bool
+	}{
+		{
+			name:         "message exists",
+			data:         map[string]interface{}{"channel_id": "C123", "text": "Deploying"},
+			messages:     []slack.Message{{Msg: slack.Msg{Timestamp: "1700000000.000100", Text: "Deploying"}}},
+			expectedID:   "C123/1700000000.000100",
+			expectedText: "Deploying",
+		},
+		{
+			name:         "text edited outside of terraform",
+			data:         map[string]interface{}{"channel_id": "C123", "text": "Deploying"},
+			messages:     []slack.Message{{Msg: slack.Msg{Timestamp: "1700000000.000100", Text: "Deployed"}}},
+			expectedID:   "C123/1700000000.000100",
+			expectedText: "Deployed",
+		},
+		{
+			name: "generated blocks of a text message are ignored",
+			data: map[string]interface{}{"channel_id": "C123", "text": "Deploying"},
+			messages: []slack.Message{{Msg: slack.Msg{
+				Timestamp: "1700000000.000100",
+				Text:      "Deploying",
+				Blocks:    slack.Blocks{BlockSet: []slack.Block{deployingBlock}},
+			}}},
+			expectedID:   "C123/1700000000.000100",
+			expectedText: "Deploying",
+		},
+		{
+			name: "fallback text of a blocks message is ignored",
+			data: map[string]interface{}{"channel_id": "C123", "blocks": `[{"type":"section","text":{"type":"mrkdwn","text":"Deployed"}}]`},
+			messages: []slack.Message{{Msg: slack.Msg{
+				Timestamp: "1700000000.000100",
+				Text:      "This content can't be displayed.",
+				Blocks:    slack.Blocks{BlockSet: []slack.Block{deployingBlock}},
+			}}},
+			expectedID:     "C123/1700000000.000100",
+			expectedBlocks: deployingBlocks,
+		},
+		{
+			name: "imported",
+			data: map[string]interface{}{"channel_id": "C123"},
+			messages: []slack.Message{{Msg: slack.Msg{
+				Timestamp: "1700000000.000100",
+				Text:      "Deploying",
+				Blocks:    slack.Blocks{BlockSet: []slack.Block{deployingBlock}},
+			}}},
+			expectedID:     "C123/1700000000.000100",
+			expectedText:   "Deploying",
+			expectedBlocks: deployingBlocks,
+		},
+		{
+			name:          "message deleted",
+			data:          map[string]interface{}{"channel_id": "C123", "text": "Deploying"},
+			messages:      []slack.Message{},
+			expectedText:  "Deploying",
+			expectWarning: true,
+		},
+		{
+			name:          "channel deleted",
+			data:          map[string]interface{}{"channel_id": "C123", "text": "Deploying"},
+			historyErr:    errors.New("channel_not_found"),
+			expectedText:  "Deploying",
+			expectWarning: true,
+		},
+		{
+			name:         "other error",
+			data:         map[string]interface{}{"channel_id": "C123", "text": "Deploying"},
+			historyErr:   errors.New("invalid_auth"),
+			expectedID:   "C123/1700000000.000100",
+			expectedText: "Deploying",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockSlackClient{
+				MockGetConversationHistory: func(_ context.Context, _ *slack.GetConversationHistoryParameters) (*slack.GetConversationHistoryResponse, error) {
+					if tt.historyErr != nil {
+						return nil, tt.historyErr
+					}
+					return &slack.GetConversationHistoryResponse{Messages: tt.messages}, nil
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackChatMessage().Schema, tt.data)
+			resourceData.SetId("C123/1700000000.000100")
+
+			diags := resourceSlackChatMessageRead(context.Background(), resourceData, config)
+
+			assert.Equal(t, tt.expectedID, resourceData.Id())
+			assert.Equal(t, tt.expectError, diags.HasError())
+			if tt.expectWarning {
+				assert.Len(t, diags, 1)
+			}
+			assert.Equal(t, tt.expectedText, resourceData.Get("text"))
+			if tt.expectedBlocks == "" {
+				assert.Empty(t, resourceData.Get("blocks"))
+			} else {
+				assert.JSONEq(t, tt.expectedBlocks, resourceData.Get("blocks").(string))
+			}
+		})
+	}
+}
+
+func TestResourceSlackChatMessageDelete(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		expectError bool
+	}{
+		{name: "deleted"},
+		{name: "already deleted", err: errors.New("message_not_found")},
+		{name: "channel gone", err: errors.New("channel_not_found")},
+		{name: "other error", err: errors.New("cant_delete_message"), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deletedTs string
+			mockClient := &MockSlackClient{
+				MockDeleteMessage: func(_ context.Context, _, timestamp string) (string, string, error) {
+					deletedTs = timestamp
+					return "", "", tt.err
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackChatMessage().Schema, map[string]interface{}{
+				"channel_id": "C123",
+				"text":       "Deploying",
+			})
+			resourceData.SetId("C123/1700000000.000100")
+
+			diags := resourceSlackChatMessageDelete(context.Background(), resourceData, config)
+
+			assert.Equal(t, "1700000000.000100", deletedTs)
+			assert.Equal(t, tt.expectError, diags.HasError())
+		})
+	}
+}
+
+func TestSuppressChatMessageTextDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		read     string
+		config   string
+		expected bool
+	}{
+		{name: "escaped ampersand", read: "R&amp;D update", config: "R&D update", expected: true},
+		{name: "escaped angle brackets", read: "1 &lt; 2 &gt; 0", config: "1 < 2 > 0", expected: true},
+		{name: "bare URL", read: "See <https://example.com/runbook>", config: "See https://example.com/runbook", expected: true},
+		{name: "link with a label", read: "See <https://example.com|the runbook>", config: "See <https://example.com|the runbook>", expected: true},
+		{name: "mention", read: "Ask <@U123>", config: "Ask <@U123>", expected: true},
+		{name: "literal tag", read: "Use &lt;b&gt;bold&lt;/b&gt;", config: "Use <b>bold</b>", expected: true},
+		{name: "edited", read: "R&amp;D update, edited", config: "R&D update", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, suppressChatMessageTextDiff("text", tt.read, tt.config, nil))
+		})
+	}
+}
+
+func TestSuppressChatMessageBlocksDiff(t *testing.T) {
+	header := `[{"type":"header","text":{"type":"plain_text","text":"Deploy freeze"}}]`
+
+	tests := []struct {
+		name     string
+		read     string
+		config   string
+		expected bool
+	}{
+		{
+			name:     "defaulted emoji and block_id",
+			read:     `[{"type":"header","text":{"type":"plain_text","text":"Deploy freeze","emoji":true},"block_id":"Xy1"}]`,
+			config:   header,
+			expected: true,
+		},
+		{
+			name:     "configured block_id",
+			read:     `[{"type":"header","text":{"type":"plain_text","text":"Deploy freeze","emoji":true},"block_id":"title"}]`,
+			config:   `[{"type":"header","block_id":"title","text":{"type":"plain_text","text":"Deploy freeze"}}]`,
+			expected: true,
+		},
+		{
+			name:     "changed block_id",
+			read:     `[{"type":"header","text":{"type":"plain_text","text":"Deploy freeze"},"block_id":"other"}]`,
+			config:   `[{"type":"header","block_id":"title","text":{"type":"plain_text","text":"Deploy freeze"}}]`,
+			expected: false,
+		},
+		{
+			name:     "edited",
+			read:     `[{"type":"header","text":{"type":"plain_text","text":"Deploys resumed","emoji":true},"block_id":"Xy1"}]`,
+			config:   header,
+			expected: false,
+		},
+		{
+			name:     "block added",
+			read:     `[{"type":"header","text":{"type":"plain_text","text":"Deploy freeze","emoji":true},"block_id":"Xy1"},{"type":"divider","block_id":"Xy2"}]`,
+			config:   header,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, suppressChatMessageBlocksDiff("blocks", tt.read, tt.config, nil))
+		})
+	}
+}
+
+func TestResourceSlackChatMessageDiff_Normalized(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "C123/1700000000.000100",
+		Attributes: map[string]string{
+			"id":         "C123/1700000000.000100",
+			"channel_id": "C123",
+			"text":       "R&amp;D update: <https://example.com/runbook>",
+			"blocks":     `[{"type":"header","text":{"type":"plain_text","text":"R&D update","emoji":true},"block_id":"Xy1"}]`,
+			"ts":         "1700000000.000100",
+			"permalink":  "https://example.slack.com/archives/C123/p1700000000000100",
+		},
+	}
+	raw := map[string]interface{}{
+		"channel_id": "C123",
+		"text":       "R&D update: https://example.com/runbook",
+		"blocks":     `[{"type":"header","text":{"type":"plain_text","text":"R&D update"}}]`,
+	}
+
+	diff, err := resourceSlackChatMessage().Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), &ProviderConfig{})
+
+	require.NoError(t, err)
+	assert.True(t, diff.Empty(), "unexpected diff: %v", diff)
+}
@@ -3,6 +3,9 @@ package slack
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,31 +15,51 @@ import (
 )
 
 const (
-	conversationActionOnDestroyNone    = "none"
-	conversationActionOnDestroyArchive = "archive"
+	// conversationDefaultDestroyMemberLimit protects channels used by a large
+	// part of a workspace from being destroyed by mistake
+	conversationDefaultDestroyMemberLimit = 100
 
-	conversationActionOnUpdatePermanentMembersNone = "none"
-	conversationActionOnUpdatePermanentMembersKick = "kick"
+	conversationActionOnDestroyNone             = "none"
+	conversationActionOnDestroyArchive          = "archive"
+	conversationActionOnDestroyDelete           = "delete"
+	conversationActionOnDestroyRenameAndArchive = "rename_and_archive"
+
+	conversationActionOnUpdatePermanentMembersNone   = "none"
+	conversationActionOnUpdatePermanentMembersKick   = "kick"
+	conversationActionOnUpdatePermanentMembersReport = "report"
 
 	// 100 is default, slack docs recommend no more than 200, but 1000 is the max.
 	// See also https://github.com/slack-go/slack/blob/master/users.go#L305
 	cursorLimit = 200
+
+	// maxConversationNameLength is the longest channel name Slack accepts
+	maxConversationNameLength = 80
 )
 
 var (
 	conversationActionValidValues = []string{
 		conversationActionOnDestroyNone,
 		conversationActionOnDestroyArchive,
+		conversationActionOnDestroyDelete,
+		conversationActionOnDestroyRenameAndArchive,
 	}
 	conversationActionOnUpdatePermanentMembersValidValues = []string{
 		conversationActionOnUpdatePermanentMembersNone,
 		conversationActionOnUpdatePermanentMembersKick,
+		conversationActionOnUpdatePermanentMembersReport,
 	}
 
 	validateConversationActionOnDestroyValue           = validation.StringInSlice(conversationActionValidValues, false)
 	validateConversationActionOnUpdatePermanentMembers = validation.StringInSlice(conversationActionOnUpdatePermanentMembersValidValues, false)
 )
 
+// ConversationInfo is a conversation as returned by conversations.info, with
+// the attributes slack-go doesn't decode
+type ConversationInfo struct {
+	slack.Channel
+	Updated int64 `json:"updated"`
+}
+
 func resourceSlackConversation() *schema.Resource {
 	return &schema.Resource{
 		ReadContext:   resourceSlackConversationRead,
@@ -48,6 +71,8 @@ func resourceSlackConversation() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: resourceTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -70,10 +95,24 @@ func resourceSlackConversation() *schema.Resource {
 				Set:      schema.HashString,
 				Optional: true,
 			},
+			"unmanaged_members": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "Members of the channel that aren't in permanent_members, only set when action_on_update_permanent_members is report",
+				Computed:    true,
+			},
 			"created": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"updated": {
+				Type:        schema.TypeInt,
+				Description: "Unix timestamp in milliseconds of the last update of the channel",
+				Computed:    true,
+			},
 			"creator": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -103,25 +142,95 @@ func resourceSlackConversation() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"num_members": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"is_member": {
+				Type:        schema.TypeBool,
+				Description: "Whether the user of the token is a member of the conversation",
+				Computed:    true,
+			},
+			"context_team_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shared_team_ids": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+			"pending_shared": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"topic_last_set_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"purpose_last_set_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"action_on_destroy": {
 				Type:         schema.TypeString,
-				Description:  "Either of none or archive",
+				Description:  "Either of none, archive, delete or rename_and_archive",
 				Optional:     true,
 				Default:      "archive",
 				ValidateFunc: validateConversationActionOnDestroyValue,
 			},
 			"action_on_update_permanent_members": {
 				Type:         schema.TypeString,
-				Description:  "Either of none or kick",
+				Description:  "Either of none, kick or report",
 				Optional:     true,
 				Default:      "kick",
 				ValidateFunc: validateConversationActionOnUpdatePermanentMembers,
 			},
+			"force_destroy": {
+				Type:        schema.TypeBool,
+				Description: "Destroy the channel even when it is the general channel, shared with another organization or above destroy_member_limit",
+				Optional:    true,
+				Default:     false,
+			},
+			"destroy_member_limit": {
+				Type:         schema.TypeInt,
+				Description:  "Refuse to destroy the channel when it has more members, 0 disables the check",
+				Optional:     true,
+				Default:      conversationDefaultDestroyMemberLimit,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_member_removals": {
+				Type:         schema.TypeInt,
+				Description:  "Abort when more members would be kicked in a single apply, overrides the provider setting",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"adopt_existing_channel": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"retention_days": {
+				Type:         schema.TypeInt,
+				Description:  "Custom message retention in days. Requires an admin token",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 		},
 	}
 }
@@ -132,19 +241,22 @@ func resourceSlackConversationCreate(ctx context.Context, d *schema.ResourceData
 
 	name := d.Get("name").(string)
 	isPrivate := d.Get("is_private").(bool)
+	retryConfig := resourceRetryConfig(d, schema.TimeoutCreate)
 
-	channel, err := client.CreateConversationContext(ctx, slack.CreateConversationParams{
-		ChannelName: name,
-		IsPrivate:   isPrivate,
+	channel, err := WithRetryWithResult(ctx, retryConfig, func() (*slack.Channel, error) {
+		return client.CreateConversationContext(ctx, slack.CreateConversationParams{
+			ChannelName: name,
+			IsPrivate:   isPrivate,
+		})
 	})
 	if err != nil && err.Error() == "name_taken" && d.Get("adopt_existing_channel").(bool) {
-		channel, err = findExistingChannel(ctx, client, name, isPrivate)
+		channel, err = WithRetryWithResult(ctx, retryConfig, func() (*slack.Channel, error) {
+			return findExistingChannel(ctx, client, name, isPrivate)
+		})
 		if err == nil && channel.IsArchived {
 			// ensure unarchived first if adopting existing channel, else other calls below will fail
-			if err := client.UnArchiveConversationContext(ctx, channel.ID); err != nil {
-				if err.Error() != "not_archived" {
-					return diag.Errorf("couldn't unarchive conversation %s: %s", channel.ID, err)
-				}
+			if err := unarchiveConversationWithContext(ctx, client, retryConfig, channel.ID); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -152,26 +264,37 @@ func resourceSlackConversationCreate(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("could not create conversation %s: %s", name, err)
 	}
 
-	err = updateChannelMembers(ctx, d, client, channel.ID)
+	err = updateChannelMembers(ctx, d, config, retryConfig, channel.ID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	if topic, ok := d.GetOk("topic"); ok {
-		if _, err := client.SetTopicOfConversationContext(ctx, channel.ID, topic.(string)); err != nil {
-			return diag.Errorf("couldn't set conversation topic %s: %s", topic.(string), err)
+		if err := setConversationTopic(ctx, client, retryConfig, channel.ID, topic.(string)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	if purpose, ok := d.GetOk("purpose"); ok {
-		if _, err := client.SetPurposeOfConversationContext(ctx, channel.ID, purpose.(string)); err != nil {
-			return diag.Errorf("couldn't set conversation purpose %s: %s", purpose.(string), err)
+		if err := setConversationPurpose(ctx, client, retryConfig, channel.ID, purpose.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if retentionDays, ok := d.GetOk("retention_days"); ok {
+		err := WithRetry(ctx, retryConfig, func() error {
+			return client.AdminConversationsSetCustomRetentionContext(ctx, channel.ID, retentionDays.(int))
+		})
+		if err != nil {
+			return diag.Errorf("couldn't set conversation retention for %s: %s", channel.ID, err)
 		}
 	}
 
 	if isArchived, ok := d.GetOk("is_archived"); ok {
 		if isArchived.(bool) {
-			err := archiveConversationWithContext(ctx, client, channel.ID)
+			err := WithRetry(ctx, retryConfig, func() error {
+				return archiveConversationWithContext(ctx, client, channel.ID)
+			})
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -183,22 +306,44 @@ func resourceSlackConversationCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func findExistingChannel(ctx context.Context, client ClientInterface, name string, isPrivate bool) (*slack.Channel, error) {
-	// find the existing channel. Sadly, there is no non-admin API to search by name,
-	// so we must search through ALL the channels
-	// Note: This function is called from within WithRetryWithResult, so rate limiting is handled by the wrapper
-	tflog.Info(ctx, "Looking for channel %s", map[string]interface{}{"channel": name})
-	paginationComplete := false
-	cursor := ""       // initial empty cursor to begin at start of list
 	var types []string // default value with empty list is "public_channel"
 	if isPrivate {
 		types = append(types, "private_channel")
 	}
+	channels, err := findChannelsByName(ctx, client, name, types, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(channels) == 0 {
+		// looked through entire list, but didn't find matching name
+		return nil, fmt.Errorf("could not find channel with name %s", name)
+	}
+	return &channels[0], nil
+}
+
+// findChannelsByName returns every conversation of the given types with the name
+func findChannelsByName(ctx context.Context, client ClientInterface, name string, types []string, includeArchived bool) ([]slack.Channel, error) {
+	tflog.Info(ctx, "Looking for channel %s", map[string]interface{}{"channel": name})
+	return searchChannels(ctx, client, types, includeArchived, func(c slack.Channel) bool {
+		tflog.Trace(ctx, "checking channel", map[string]interface{}{"channel": c.Name})
+		return c.Name == name
+	})
+}
+
+// searchChannels returns the conversations of the given types that match.
+// Sadly, there is no non-admin API to search conversations, so we must go
+// through ALL of them.
+// Note: This function is called from within WithRetryWithResult, so rate limiting is handled by the wrapper
+func searchChannels(ctx context.Context, client ClientInterface, types []string, includeArchived bool, match func(slack.Channel) bool) ([]slack.Channel, error) {
+	var matches []slack.Channel
+	paginationComplete := false
+	cursor := "" // initial empty cursor to begin at start of list
 	for !paginationComplete {
 		channels, nextCursor, err := client.GetConversationsContext(ctx, &slack.GetConversationsParameters{
 			Cursor:          cursor,
 			Limit:           cursorLimit,
 			Types:           types,
-			ExcludeArchived: true,
+			ExcludeArchived: !includeArchived,
 		})
 		tflog.Debug(ctx, "new page of channels",
 			map[string]interface{}{
@@ -209,69 +354,113 @@ func findExistingChannel(ctx context.Context, client ClientInterface, name strin
 			return nil, fmt.Errorf("couldn't get conversation context: %s", err.Error())
 		}
 
-		// see if channel in current batch
 		for _, c := range channels {
-			tflog.Trace(ctx, "checking channel", map[string]interface{}{"channel": c.Name})
-			if c.Name == name {
-				tflog.Info(ctx, "found channel")
-				return &c, nil
+			if match(c) {
+				matches = append(matches, c)
 			}
 		}
-		// not found so far, move on to next cursor, if pagination incomplete
+		// move on to next cursor, if pagination incomplete
 		paginationComplete = nextCursor == ""
 		cursor = nextCursor
 	}
-	// looked through entire list, but didn't find matching name
-	return nil, fmt.Errorf("could not find channel with name %s", name)
+	return matches, nil
+}
+
+// conversationMembersUpdate holds the changes to the members of a conversation
+type conversationMembersUpdate struct {
+	invite []string
+	kick   []string
+}
+
+func updateChannelMembers(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, retryConfig *RetryConfig, channelID string) error {
+	update, err := planChannelMembers(ctx, d, config, retryConfig, channelID)
+	if err != nil {
+		return err
+	}
+	return applyChannelMembers(ctx, config.Client, retryConfig, channelID, update)
 }
 
-func updateChannelMembers(ctx context.Context, d *schema.ResourceData, client ClientInterface, channelID string) error {
+// planChannelMembers returns the users to invite to and kick from a
+// conversation, without changing it, and enforces max_member_removals
+func planChannelMembers(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, retryConfig *RetryConfig, channelID string) (*conversationMembersUpdate, error) {
+	client := config.Client
 	members := d.Get("permanent_members").(*schema.Set)
 
 	userIDs := schemaSetToSlice(members)
-	channel, err := client.GetConversationInfoContext(ctx, &slack.GetConversationInfoInput{
-		ChannelID: channelID,
+	channel, err := WithRetryWithResult(ctx, retryConfig, func() (*slack.Channel, error) {
+		return client.GetConversationInfoContext(ctx, &slack.GetConversationInfoInput{
+			ChannelID: channelID,
+		})
 	})
 	if err != nil {
-		return fmt.Errorf("could not retrieve conversation info for ID %s: %w", channelID, err)
+		return nil, fmt.Errorf("could not retrieve conversation info for ID %s: %w", channelID, err)
 	}
 
-	apiUserInfo, err := client.AuthTest()
+	apiUserInfo, err := config.authTest(ctx, retryConfig)
 
 	if err != nil {
-		return fmt.Errorf("error authenticating with slack %w", err)
+		return nil, fmt.Errorf("error authenticating with slack %w", err)
 	}
 	userIDs = remove(userIDs, apiUserInfo.UserID)
 	userIDs = remove(userIDs, channel.Creator)
+	update := &conversationMembersUpdate{invite: userIDs}
 
-	channelUsers, _, err := client.GetUsersInConversationContext(ctx, &slack.GetUsersInConversationParameters{
-		ChannelID: channel.ID,
-	})
+	if d.Get("action_on_update_permanent_members").(string) != conversationActionOnUpdatePermanentMembersKick {
+		return update, nil
+	}
 
+	channelUsers, err := getConversationMembers(ctx, client, retryConfig, channel.ID)
 	if err != nil {
-		return fmt.Errorf("could not retrieve conversation users for ID %s: %w", channelID, err)
+		return nil, fmt.Errorf("could not retrieve conversation users for ID %s: %w", channelID, err)
+	}
+	update.kick = unmanagedConversationMembers(channelUsers, userIDs, channel.Creator, apiUserInfo.UserID)
+
+	maxRemovals := conversationMaxMemberRemovals(d, config)
+	if maxRemovals > 0 && len(update.kick) > maxRemovals {
+		return nil, fmt.Errorf("refusing to kick %d users from conversation %s, more than max_member_removals %d: %s",
+			len(update.kick), channelID, maxRemovals, strings.Join(update.kick, ", "))
 	}
+	return update, nil
+}
+
+// conversationMaxMemberRemovals returns the max_member_removals of the
+// conversation when configured, even to 0, and the provider one otherwise
+func conversationMaxMemberRemovals(d *schema.ResourceData, config *ProviderConfig) int {
+	raw, ok := objectAttribute(d.GetRawConfig(), "max_member_removals")
+	if !ok || raw.IsNull() || !raw.IsKnown() {
+		return config.MaxMemberRemovals
+	}
+	maxRemovals, _ := raw.AsBigFloat().Int64()
+	return int(maxRemovals)
+}
 
+func applyChannelMembers(ctx context.Context, client ClientInterface, retryConfig *RetryConfig, channelID string, update *conversationMembersUpdate) error {
 	// first, ensure the api user is in the channel, otherwise other member modifications below may fail
-	if _, _, _, err := client.JoinConversationContext(ctx, channelID); err != nil {
+	err := WithRetry(ctx, retryConfig, func() error {
+		_, _, _, err := client.JoinConversationContext(ctx, channelID)
+		return err
+	})
+	if err != nil {
 		if err.Error() != "already_in_channel" && err.Error() != "method_not_supported_for_channel_type" {
 			return fmt.Errorf("api user could not join conversation: %w", err)
 		}
 	}
 
-	action := d.Get("action_on_update_permanent_members").(string)
-	if action == conversationActionOnUpdatePermanentMembersKick {
-		for _, currentMember := range channelUsers {
-			if currentMember != channel.Creator && currentMember != apiUserInfo.UserID && !contains(userIDs, currentMember) {
-				if err := client.KickUserFromConversationContext(ctx, channelID, currentMember); err != nil {
-					return fmt.Errorf("couldn't kick user from conversation: %w", err)
-				}
-			}
+	for _, currentMember := range update.kick {
+		err := WithRetry(ctx, retryConfig, func() error {
+			return client.KickUserFromConversationContext(ctx, channelID, currentMember)
+		})
+		if err != nil {
+			return fmt.Errorf("couldn't kick user from conversation: %w", err)
 		}
 	}
 
-	if len(userIDs) > 0 {
-		if _, err := client.InviteUsersToConversationContext(ctx, channelID, userIDs...); err != nil {
+	if len(update.invite) > 0 {
+		err := WithRetry(ctx, retryConfig, func() error {
+			_, err := client.InviteUsersToConversationContext(ctx, channelID, update.invite...)
+			return err
+		})
+		if err != nil {
 			if err.Error() != "already_in_channel" {
 				return fmt.Errorf("couldn't invite users to conversation: %w", err)
 			}
@@ -284,17 +473,18 @@ func updateChannelMembers(ctx context.Context, d *schema.ResourceData, client Cl
 func resourceSlackConversationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*ProviderConfig)
 	client := config.Client
+	retryConfig := resourceRetryConfig(d, schema.TimeoutRead)
 	id := d.Id()
 	var (
-		diags   diag.Diagnostics
-		channel *slack.Channel
-		users   []string
-		err     error
+		diags diag.Diagnostics
+		users []string
 	)
 
-	channel, err = WithRetryWithResult(ctx, config.RetryConfig, func() (*slack.Channel, error) {
-		return client.GetConversationInfoContext(ctx, &slack.GetConversationInfoInput{
-			ChannelID: id,
+	info, err := WithRetryWithResult(ctx, retryConfig, func() (*ConversationInfo, error) {
+		return client.GetConversationDetailsContext(ctx, &slack.GetConversationInfoInput{
+			ChannelID:         id,
+			IncludeNumMembers: true,
+			IncludeLocale:     true,
 		})
 	})
 	if err != nil {
@@ -311,18 +501,58 @@ func resourceSlackConversationRead(ctx context.Context, d *schema.ResourceData,
 	if d.Id() == "" {
 		return diags
 	}
-
-	err = WithRetry(ctx, config.RetryConfig, func() error {
-		var retryErr error
-		users, _, retryErr = client.GetUsersInConversationContext(ctx, &slack.GetUsersInConversationParameters{
-			ChannelID: channel.ID,
+	channel := &info.Channel
+
+	// retention is only read when managed, as it requires an admin user token
+	// on an Enterprise Grid organization
+	if _, ok := d.GetOk("retention_days"); ok {
+		var (
+			durationDays    int
+			isPolicyEnabled bool
+		)
+		err = WithRetry(ctx, retryConfig, func() error {
+			var retryErr error
+			durationDays, isPolicyEnabled, retryErr = client.AdminConversationsGetCustomRetentionContext(ctx, channel.ID)
+			return retryErr
 		})
-		return retryErr
-	})
+		if err != nil {
+			return diag.Errorf("couldn't get conversation retention for %s: %s", channel.ID, err)
+		}
+		if !isPolicyEnabled {
+			durationDays = 0
+		}
+		if err := d.Set("retention_days", durationDays); err != nil {
+			return diag.Errorf("error setting retention_days: %s", err)
+		}
+	}
+
+	apiUserInfo, err := config.authTest(ctx, retryConfig)
 	if err != nil {
-		return diag.Errorf("couldn't get users in conversation for %s: %s", channel.ID, err)
+		return diag.Errorf("error authenticating with slack %s", err)
+	}
+
+	// the members are only listed in report mode, as large channels take many calls
+	var unmanagedMembers []string
+	if d.Get("action_on_update_permanent_members").(string) == conversationActionOnUpdatePermanentMembersReport {
+		users, err = getConversationMembers(ctx, client, retryConfig, channel.ID)
+		if err != nil {
+			return diag.Errorf("couldn't get users in conversation for %s: %s", channel.ID, err)
+		}
+		permanentMembers := schemaSetToSlice(d.Get("permanent_members").(*schema.Set))
+		unmanagedMembers = unmanagedConversationMembers(users, permanentMembers, channel.Creator, apiUserInfo.UserID)
+		if len(unmanagedMembers) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("conversation %s (%s) has %d members not in permanent_members", channel.ID, channel.Name, len(unmanagedMembers)),
+				Detail:   fmt.Sprintf("unmanaged members: %s", strings.Join(unmanagedMembers, ", ")),
+			})
+		}
+	}
+	if err := d.Set("unmanaged_members", unmanagedMembers); err != nil {
+		return diag.Errorf("error setting unmanaged_members: %s", err)
 	}
-	return updateChannelData(d, channel, users)
+
+	return append(diags, updateChannelData(d, info, users, apiUserInfo.URL)...)
 }
 
 func resourceSlackConversationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -330,50 +560,81 @@ func resourceSlackConversationUpdate(ctx context.Context, d *schema.ResourceData
 	client := config.Client
 
 	id := d.Id()
+	retryConfig := resourceRetryConfig(d, schema.TimeoutUpdate)
+
+	// the members are planned before any change, so that a refused kick
+	// leaves the conversation unchanged
+	var membersUpdate *conversationMembersUpdate
+	if d.HasChange("permanent_members") {
+		var err error
+		membersUpdate, err = planChannelMembers(ctx, d, config, retryConfig, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	if d.HasChange("name") {
-		if _, err := client.RenameConversationContext(ctx, id, d.Get("name").(string)); err != nil {
+		err := WithRetry(ctx, retryConfig, func() error {
+			_, err := client.RenameConversationContext(ctx, id, d.Get("name").(string))
+			return err
+		})
+		if err != nil {
 			return diag.Errorf("couldn't rename conversation: %s", err)
 		}
 	}
 
 	if d.HasChange("topic") {
-		topic := d.Get("topic")
-		if _, err := client.SetTopicOfConversationContext(ctx, id, topic.(string)); err != nil {
-			return diag.Errorf("couldn't set conversation topic %s: %s", topic.(string), err)
+		if err := setConversationTopic(ctx, client, retryConfig, id, d.Get("topic").(string)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("purpose") {
-		purpose := d.Get("purpose")
-		if _, err := client.SetPurposeOfConversationContext(ctx, id, purpose.(string)); err != nil {
-			return diag.Errorf("couldn't set conversation purpose %s: %s", purpose.(string), err)
+		if err := setConversationPurpose(ctx, client, retryConfig, id, d.Get("purpose").(string)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("is_archived") {
 		isArchived := d.Get("is_archived")
 		if isArchived.(bool) {
-			err := archiveConversationWithContext(ctx, client, id)
+			err := WithRetry(ctx, retryConfig, func() error {
+				return archiveConversationWithContext(ctx, client, id)
+			})
 			if err != nil {
 				return diag.FromErr(err)
 			}
 		} else {
-			if err := client.UnArchiveConversationContext(ctx, id); err != nil {
-				if err.Error() != "not_archived" {
-					return diag.Errorf("couldn't unarchive conversation %s: %s", id, err)
-				}
+			if err := unarchiveConversationWithContext(ctx, client, retryConfig, id); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	if d.HasChange("permanent_members") {
-		err := updateChannelMembers(ctx, d, client, id)
-		if err != nil {
+	if membersUpdate != nil {
+		if err := applyChannelMembers(ctx, client, retryConfig, id, membersUpdate); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
+	if d.HasChange("retention_days") {
+		if retentionDays := d.Get("retention_days").(int); retentionDays > 0 {
+			err := WithRetry(ctx, retryConfig, func() error {
+				return client.AdminConversationsSetCustomRetentionContext(ctx, id, retentionDays)
+			})
+			if err != nil {
+				return diag.Errorf("couldn't set conversation retention for %s: %s", id, err)
+			}
+		} else {
+			err := WithRetry(ctx, retryConfig, func() error {
+				return client.AdminConversationsRemoveCustomRetentionContext(ctx, id)
+			})
+			if err != nil {
+				return diag.Errorf("couldn't remove conversation retention for %s: %s", id, err)
+			}
+		}
+	}
+
 	return resourceSlackConversationRead(ctx, d, m)
 }
 
@@ -381,9 +642,33 @@ func resourceSlackConversationDelete(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 	config := m.(*ProviderConfig)
 	client := config.Client
+	retryConfig := resourceRetryConfig(d, schema.TimeoutDelete)
 
 	id := d.Id()
 	action := d.Get("action_on_destroy").(string)
+
+	if action != conversationActionOnDestroyNone && !d.Get("force_destroy").(bool) {
+		channel, err := WithRetryWithResult(ctx, retryConfig, func() (*slack.Channel, error) {
+			return client.GetConversationInfoContext(ctx, &slack.GetConversationInfoInput{
+				ChannelID:         id,
+				IncludeNumMembers: true,
+			})
+		})
+		if err != nil {
+			if err.Error() == "channel_not_found" {
+				return diags
+			}
+			return diag.Errorf("couldn't get conversation info for %s: %s", id, err)
+		}
+		if err := checkConversationDestroy(channel, d.Get("destroy_member_limit").(int)); err != nil {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("refusing to destroy conversation %s (%s): %s", id, channel.Name, err),
+				Detail:   "set force_destroy to true and apply before destroying the conversation",
+			}}
+		}
+	}
+
 	switch action {
 	case conversationActionOnDestroyNone:
 		diags = append(diags, diag.Diagnostic{
@@ -392,13 +677,48 @@ func resourceSlackConversationDelete(ctx context.Context, d *schema.ResourceData
 			Detail:   fmt.Sprintf("action_on_destroy is set to %s which does not archive the conversation ", conversationActionOnDestroyNone),
 		})
 	case conversationActionOnDestroyArchive:
-		err := archiveConversationWithContext(ctx, client, id)
+		err := WithRetry(ctx, retryConfig, func() error {
+			return archiveConversationWithContext(ctx, client, id)
+		})
 		if err != nil {
 			if err.Error() == "channel_not_found" {
 				return diags
 			}
 			return diag.FromErr(err)
 		}
+	case conversationActionOnDestroyDelete:
+		err := WithRetry(ctx, retryConfig, func() error {
+			return client.AdminConversationsDeleteContext(ctx, id)
+		})
+		if err != nil {
+			if err.Error() == "channel_not_found" {
+				return diags
+			}
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("couldn't delete conversation %s: %s", id, err),
+				Detail:   fmt.Sprintf("action_on_destroy is set to %s which requires an admin user token", conversationActionOnDestroyDelete),
+			}}
+		}
+	case conversationActionOnDestroyRenameAndArchive:
+		// free the name first, so a channel with the same name can be created right away
+		name := archivedConversationName(d.Get("name").(string), time.Now())
+		err := WithRetry(ctx, retryConfig, func() error {
+			_, err := client.RenameConversationContext(ctx, id, name)
+			return err
+		})
+		if err != nil {
+			if err.Error() == "channel_not_found" {
+				return diags
+			}
+			return diag.Errorf("couldn't rename conversation %s to %s: %s", id, name, err)
+		}
+		err = WithRetry(ctx, retryConfig, func() error {
+			return archiveConversationWithContext(ctx, client, id)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	default:
 		return diag.Errorf("unknown action_on_destroy value. Valid values are %v", conversationActionValidValues)
 	}
@@ -406,7 +726,8 @@ func resourceSlackConversationDelete(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
-func updateChannelData(d *schema.ResourceData, channel *slack.Channel, _ []string) diag.Diagnostics {
+func updateChannelData(d *schema.ResourceData, info *ConversationInfo, _ []string, teamURL string) diag.Diagnostics {
+	channel := &info.Channel
 	if channel.ID == "" {
 		return diag.Errorf("error setting id: returned channel does not have an id")
 	}
@@ -450,6 +771,10 @@ func updateChannelData(d *schema.ResourceData, channel *slack.Channel, _ []strin
 		return diag.Errorf("error setting created: %s", err)
 	}
 
+	if err := d.Set("updated", info.Updated); err != nil {
+		return diag.Errorf("error setting updated: %s", err)
+	}
+
 	if err := d.Set("creator", channel.Creator); err != nil {
 		return diag.Errorf("error setting creator: %s", err)
 	}
@@ -462,18 +787,165 @@ func updateChannelData(d *schema.ResourceData, channel *slack.Channel, _ []strin
 		return diag.Errorf("error setting is_general: %s", err)
 	}
 
+	if err := d.Set("num_members", channel.NumMembers); err != nil {
+		return diag.Errorf("error setting num_members: %s", err)
+	}
+
+	if err := d.Set("is_member", channel.IsMember); err != nil {
+		return diag.Errorf("error setting is_member: %s", err)
+	}
+
+	if err := d.Set("context_team_id", channel.ContextTeamID); err != nil {
+		return diag.Errorf("error setting context_team_id: %s", err)
+	}
+
+	if err := d.Set("shared_team_ids", channel.SharedTeamIDs); err != nil {
+		return diag.Errorf("error setting shared_team_ids: %s", err)
+	}
+
+	if err := d.Set("pending_shared", channel.PendingShared); err != nil {
+		return diag.Errorf("error setting pending_shared: %s", err)
+	}
+
+	if err := d.Set("locale", channel.Locale); err != nil {
+		return diag.Errorf("error setting locale: %s", err)
+	}
+
+	if err := d.Set("topic_last_set_by", channel.Topic.Creator); err != nil {
+		return diag.Errorf("error setting topic_last_set_by: %s", err)
+	}
+
+	if err := d.Set("purpose_last_set_by", channel.Purpose.Creator); err != nil {
+		return diag.Errorf("error setting purpose_last_set_by: %s", err)
+	}
+
+	if err := d.Set("url", conversationURL(teamURL, channel.ID)); err != nil {
+		return diag.Errorf("error setting url: %s", err)
+	}
+
 	return nil
 }
 
+// conversationURL returns the link to a conversation from the URL of its
+// workspace, e.g. https://example.slack.com/archives/C023X7QTFHQ
+func conversationURL(teamURL, channelID string) string {
+	if teamURL == "" {
+		return ""
+	}
+	return strings.TrimSuffix(teamURL, "/") + "/archives/" + channelID
+}
+
 func archiveConversationWithContext(ctx context.Context, client ClientInterface, id string) error {
 	if err := client.ArchiveConversationContext(ctx, id); err != nil {
 		if err.Error() != "already_archived" {
-			return fmt.Errorf("couldn't archive conversation %s: %s", id, err)
+			return fmt.Errorf("couldn't archive conversation %s: %w", id, err)
 		}
 	}
 	return nil
 }
 
+func unarchiveConversationWithContext(ctx context.Context, client ClientInterface, retryConfig *RetryConfig, id string) error {
+	err := WithRetry(ctx, retryConfig, func() error {
+		return client.UnArchiveConversationContext(ctx, id)
+	})
+	if err != nil && err.Error() != "not_archived" {
+		return fmt.Errorf("couldn't unarchive conversation %s: %s", id, err)
+	}
+	return nil
+}
+
+func setConversationTopic(ctx context.Context, client ClientInterface, retryConfig *RetryConfig, id, topic string) error {
+	err := WithRetry(ctx, retryConfig, func() error {
+		_, err := client.SetTopicOfConversationContext(ctx, id, topic)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't set conversation topic %s: %s", topic, err)
+	}
+	return nil
+}
+
+func setConversationPurpose(ctx context.Context, client ClientInterface, retryConfig *RetryConfig, id, purpose string) error {
+	err := WithRetry(ctx, retryConfig, func() error {
+		_, err := client.SetPurposeOfConversationContext(ctx, id, purpose)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't set conversation purpose %s: %s", purpose, err)
+	}
+	return nil
+}
+
+// checkConversationDestroy returns an error when destroying the conversation
+// would have a large impact
+func checkConversationDestroy(channel *slack.Channel, memberLimit int) error {
+	if channel.IsGeneral {
+		return fmt.Errorf("it is the general channel")
+	}
+	if channel.IsExtShared {
+		return fmt.Errorf("it is shared with another organization")
+	}
+	if memberLimit > 0 && channel.NumMembers > memberLimit {
+		return fmt.Errorf("it has %d members, more than destroy_member_limit %d", channel.NumMembers, memberLimit)
+	}
+	return nil
+}
+
+// archivedConversationName returns the name a conversation is renamed to before
+// being archived, e.g. my-channel-archived-2024-01-31. Slack limits names in
+// characters, so long names are truncated on rune boundaries.
+func archivedConversationName(name string, now time.Time) string {
+	suffix := "-archived-" + now.Format("2006-01-02")
+	runes := []rune(name)
+	if len(runes)+len(suffix) > maxConversationNameLength {
+		name = string(runes[:maxConversationNameLength-len(suffix)])
+	}
+	return name + suffix
+}
+
+// getConversationMembers returns every member of a conversation, going through
+// all the pages of conversations.members
+func getConversationMembers(ctx context.Context, client ClientInterface, retryConfig *RetryConfig, channelID string) ([]string, error) {
+	var members []string
+	cursor := ""
+	for {
+		var (
+			page       []string
+			nextCursor string
+		)
+		err := WithRetry(ctx, retryConfig, func() error {
+			var retryErr error
+			page, nextCursor, retryErr = client.GetUsersInConversationContext(ctx, &slack.GetUsersInConversationParameters{
+				ChannelID: channelID,
+				Cursor:    cursor,
+				Limit:     cursorLimit,
+			})
+			return retryErr
+		})
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, page...)
+		if nextCursor == "" {
+			return members, nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// unmanagedConversationMembers returns the members of a conversation that are
+// neither permanent members, nor its creator or the API user
+func unmanagedConversationMembers(members, permanentMembers []string, creator, apiUserID string) []string {
+	var unmanaged []string
+	for _, member := range members {
+		if member != creator && member != apiUserID && !contains(permanentMembers, member) {
+			unmanaged = append(unmanaged, member)
+		}
+	}
+	sort.Strings(unmanaged)
+	return unmanaged
+}
+
 func contains(s []string, e string) bool {
 	var found bool
 	for _, x := range s {
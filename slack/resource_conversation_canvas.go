@@ -0,0 +1,182 @@
+package slack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+)
+
+func resourceSlackConversationCanvas() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackConversationCanvasRead,
+		CreateContext: resourceSlackConversationCanvasCreate,
+		UpdateContext: resourceSlackConversationCanvasUpdate,
+		DeleteContext: resourceSlackConversationCanvasDelete,
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"markdown": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"content_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 of the canvas content as last written by terraform, used to detect drift",
+			},
+		},
+	}
+}
+
+func resourceSlackConversationCanvasCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	channelID := d.Get("channel_id").(string)
+	markdown := d.Get("markdown").(string)
+
+	canvasID, err := WithRetryWithResult(ctx, config.RetryConfig, func() (string, error) {
+		return client.CreateChannelCanvasContext(ctx, channelID, canvasDocumentContent(markdown))
+	})
+	if err != nil {
+		return diag.Errorf("could not create canvas for conversation %s: %s", channelID, err)
+	}
+	d.SetId(canvasID)
+
+	if err := setCanvasContentHash(ctx, d, config); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceSlackConversationCanvasRead(ctx, d, m)
+}
+
+func resourceSlackConversationCanvasRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+	id := d.Id()
+	var diags diag.Diagnostics
+
+	content, err := WithRetryWithResult(ctx, config.RetryConfig, func() ([]byte, error) {
+		return client.GetCanvasContentContext(ctx, id)
+	})
+	if err != nil {
+		if isCanvasNotFoundError(err) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("canvas with ID %s not found, removing from state", id),
+			})
+			d.SetId("")
+			return diags
+		}
+		return diag.Errorf("couldn't get canvas content for %s: %s", id, err)
+	}
+
+	hash := canvasContentHash(content)
+	if previous := d.Get("content_hash").(string); previous != "" && previous != hash {
+		// the canvas was edited outside of terraform. The markdown can't be
+		// recovered from the rendered canvas, so clear it to force an update.
+		tflog.Info(ctx, "canvas content changed outside of terraform", map[string]interface{}{"canvas": id})
+		if err := d.Set("markdown", ""); err != nil {
+			return diag.Errorf("error setting markdown: %s", err)
+		}
+	}
+
+	if err := d.Set("content_hash", hash); err != nil {
+		return diag.Errorf("error setting content_hash: %s", err)
+	}
+	return diags
+}
+
+func resourceSlackConversationCanvasUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	id := d.Id()
+
+	if d.HasChange("markdown") {
+		err := WithRetry(ctx, config.RetryConfig, func() error {
+			return client.EditCanvasContext(ctx, slack.EditCanvasParams{
+				CanvasID: id,
+				Changes: []slack.CanvasChange{
+					{
+						Operation:       "replace",
+						DocumentContent: canvasDocumentContent(d.Get("markdown").(string)),
+					},
+				},
+			})
+		})
+		if err != nil {
+			return diag.Errorf("couldn't edit canvas %s: %s", id, err)
+		}
+
+		if err := setCanvasContentHash(ctx, d, config); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSlackConversationCanvasRead(ctx, d, m)
+}
+
+func resourceSlackConversationCanvasDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	id := d.Id()
+	err := WithRetry(ctx, config.RetryConfig, func() error {
+		return client.DeleteCanvasContext(ctx, id)
+	})
+	if err != nil {
+		if isCanvasNotFoundError(err) {
+			return diags
+		}
+		return diag.Errorf("couldn't delete canvas %s: %s", id, err)
+	}
+
+	return diags
+}
+
+// setCanvasContentHash records the hash of the canvas as Slack rendered it
+// right after terraform wrote it, so the following reads don't report drift.
+func setCanvasContentHash(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
+	id := d.Id()
+	content, err := WithRetryWithResult(ctx, config.RetryConfig, func() ([]byte, error) {
+		return config.Client.GetCanvasContentContext(ctx, id)
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't get canvas content for %s: %w", id, err)
+	}
+	if err := d.Set("content_hash", canvasContentHash(content)); err != nil {
+		return fmt.Errorf("error setting content_hash: %w", err)
+	}
+	return nil
+}
+
+func canvasDocumentContent(markdown string) slack.DocumentContent {
+	return slack.DocumentContent{
+		Type:     "markdown",
+		Markdown: markdown,
+	}
+}
+
+func canvasContentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func isCanvasNotFoundError(err error) bool {
+	switch err.Error() {
+	case "file_not_found", "file_deleted", "canvas_not_found", "canvas_deleted":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSlackConversationCanvasTest(t *testing.T) {
+	resourceName := "slack_conversation_canvas.test"
+	name := acctest.RandomWithPrefix(conversationNamePrefix)
+
+	var providers []*schema.Provider
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckConversationCanvasDestroy,
+			testAccCheckConversationDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackConversationCanvasConfig(name, "# About this channel"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", "slack_conversation.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "markdown", "# About this channel"),
+					resource.TestCheckResourceAttrSet(resourceName, "content_hash"),
+				),
+			},
+			{
+				Config: testAccSlackConversationCanvasConfig(name, "# About this project"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "markdown", "# About this project"),
+					resource.TestCheckResourceAttrSet(resourceName, "content_hash"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConversationCanvasDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderConfig).Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "slack_conversation_canvas" {
+			continue
+		}
+
+		_, err := client.GetCanvasContentContext(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("canvas %s still exists", rs.Primary.ID)
+		}
+		if !isCanvasNotFoundError(err) {
+			return fmt.Errorf("error getting canvas %s: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccSlackConversationCanvasConfig(name, markdown string) string {
+	return testAccSlackConversationDependencyConfig(name) + fmt.Sprintf(`
+resource slack_conversation_canvas test {
+  channel_id = slack_conversation.test.id
+  markdown   = "%s"
+}
+`, markdown)
+}
@@ -0,0 +1,128 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceSlackConversationCanvasCreate(t *testing.T) {
+	var (
+		createdChannel string
+		createdContent slack.DocumentContent
+	)
+	mockClient := &MockSlackClient{
+		MockCreateChannelCanvas: func(_ context.Context, channelID string, content slack.DocumentContent) (string, error) {
+			createdChannel = channelID
+			createdContent = content
+			return "F123", nil
+		},
+		MockGetCanvasContent: func(_ context.Context, _ string) ([]byte, error) {
+			return []byte("<h1>About this channel</h1>"), nil
+		},
+	}
+	config := &ProviderConfig{
+		Client:      mockClient,
+		RetryConfig: DefaultRetryConfig(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSlackConversationCanvas().Schema, map[string]interface{}{
+		"channel_id": "C123",
+		"markdown":   "# About this channel",
+	})
+
+	diags := resourceSlackConversationCanvasCreate(context.Background(), resourceData, config)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "F123", resourceData.Id())
+	assert.Equal(t, "C123", createdChannel)
+	assert.Equal(t, canvasDocumentContent("# About this channel"), createdContent)
+	assert.Equal(t, canvasContentHash([]byte("<h1>About this channel</h1>")), resourceData.Get("content_hash"))
+	assert.Equal(t, "# About this channel", resourceData.Get("markdown"))
+}
+
+func TestResourceSlackConversationCanvasRead(t *testing.T) {
+	tests := []struct {
+		name             string
+		contentHash      string
+		mockContent      []byte
+		mockError        error
+		expectedID       string
+		expectedMarkdown string
+		expectedWarning  string
+		expectedError    string
+	}{
+		{
+			name:             "content unchanged",
+			contentHash:      canvasContentHash([]byte("content")),
+			mockContent:      []byte("content"),
+			expectedID:       "F123",
+			expectedMarkdown: "# About",
+		},
+		{
+			name:             "content changed outside of terraform",
+			contentHash:      canvasContentHash([]byte("content")),
+			mockContent:      []byte("edited by hand"),
+			expectedID:       "F123",
+			expectedMarkdown: "",
+		},
+		{
+			name:             "imported canvas without hash",
+			mockContent:      []byte("content"),
+			expectedID:       "F123",
+			expectedMarkdown: "# About",
+		},
+		{
+			name:            "canvas deleted",
+			mockError:       errors.New("file_deleted"),
+			expectedWarning: "canvas with ID F123 not found, removing from state",
+		},
+		{
+			name:          "API error",
+			mockError:     errors.New("invalid_auth"),
+			expectedError: "couldn't get canvas content for F123: invalid_auth",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockSlackClient{
+				MockGetCanvasContent: func(_ context.Context, _ string) ([]byte, error) {
+					return tt.mockContent, tt.mockError
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackConversationCanvas().Schema, map[string]interface{}{
+				"channel_id":   "C123",
+				"markdown":     "# About",
+				"content_hash": tt.contentHash,
+			})
+			resourceData.SetId("F123")
+
+			diags := resourceSlackConversationCanvasRead(context.Background(), resourceData, config)
+
+			switch {
+			case tt.expectedError != "":
+				assert.True(t, diags.HasError())
+				assert.Equal(t, tt.expectedError, diags[0].Summary)
+			case tt.expectedWarning != "":
+				assert.False(t, diags.HasError())
+				assert.Equal(t, tt.expectedWarning, diags[0].Summary)
+				assert.Empty(t, resourceData.Id())
+			default:
+				assert.Empty(t, diags)
+				assert.Equal(t, tt.expectedID, resourceData.Id())
+				assert.Equal(t, tt.expectedMarkdown, resourceData.Get("markdown"))
+				assert.Equal(t, canvasContentHash(tt.mockContent), resourceData.Get("content_hash"))
+			}
+		})
+	}
+}
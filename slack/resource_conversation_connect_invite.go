@@ -0,0 +1,218 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+)
+
+// ConnectInvite is a Slack Connect invitation as returned by conversations.listConnectInvites
+type ConnectInvite struct {
+	Direction string `json:"direction"`
+	Status    string `json:"status"`
+	Invite    struct {
+		ID              string `json:"id"`
+		RecipientEmail  string `json:"recipient_email"`
+		RecipientUserID string `json:"recipient_user_id"`
+	} `json:"invite"`
+	Channel struct {
+		ID string `json:"id"`
+	} `json:"channel"`
+	Acceptances []struct {
+		ApprovalStatus string `json:"approval_status"`
+		AcceptingTeam  struct {
+			ID string `json:"id"`
+		} `json:"accepting_team"`
+	} `json:"acceptances"`
+}
+
+func resourceSlackConversationConnectInvite() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackConversationConnectInviteRead,
+		CreateContext: resourceSlackConversationConnectInviteCreate,
+		DeleteContext: resourceSlackConversationConnectInviteDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"email", "user_id"},
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"email", "user_id"},
+			},
+			"external_limited": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"invite_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"team_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the external organization once it accepted the invite. Organizations are invited through one of their users, not by team ID",
+				Computed:    true,
+			},
+			"is_legacy_shared_channel": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSlackConversationConnectInviteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	channelID := d.Get("channel_id").(string)
+	externalLimited := d.Get("external_limited").(bool)
+	params := slack.InviteSharedToConversationParams{
+		ChannelID:       channelID,
+		ExternalLimited: &externalLimited,
+	}
+	if email, ok := d.GetOk("email"); ok {
+		params.Emails = []string{email.(string)}
+	}
+	if userID, ok := d.GetOk("user_id"); ok {
+		params.UserIDs = []string{userID.(string)}
+	}
+
+	inviteID, isLegacySharedChannel, err := client.InviteSharedToConversationContext(ctx, params)
+	if err != nil {
+		return diag.Errorf("could not invite to shared conversation %s: %s", channelID, err)
+	}
+	d.SetId(inviteID)
+
+	if err := d.Set("is_legacy_shared_channel", isLegacySharedChannel); err != nil {
+		return diag.Errorf("error setting is_legacy_shared_channel: %s", err)
+	}
+
+	return resourceSlackConversationConnectInviteRead(ctx, d, m)
+}
+
+func resourceSlackConversationConnectInviteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+	id := d.Id()
+	var diags diag.Diagnostics
+
+	invite, err := WithRetryWithResult(ctx, config.RetryConfig, func() (*ConnectInvite, error) {
+		return findConnectInvite(ctx, client, id)
+	})
+	if err != nil {
+		return diag.Errorf("couldn't list connect invites: %s", err)
+	}
+	if invite == nil {
+		if d.Get("channel_id").(string) == "" {
+			return diag.Errorf("could not find connect invite with ID %s", id)
+		}
+		// Slack stops listing invites some time after they are accepted, so
+		// keep the last known state rather than dropping the resource.
+		tflog.Debug(ctx, "connect invite no longer listed, keeping state", map[string]interface{}{"invite": id})
+		return diags
+	}
+
+	if err := d.Set("invite_id", invite.Invite.ID); err != nil {
+		return diag.Errorf("error setting invite_id: %s", err)
+	}
+
+	if err := d.Set("channel_id", invite.Channel.ID); err != nil {
+		return diag.Errorf("error setting channel_id: %s", err)
+	}
+
+	if invite.Invite.RecipientEmail != "" {
+		if err := d.Set("email", invite.Invite.RecipientEmail); err != nil {
+			return diag.Errorf("error setting email: %s", err)
+		}
+	}
+
+	if invite.Invite.RecipientUserID != "" {
+		if err := d.Set("user_id", invite.Invite.RecipientUserID); err != nil {
+			return diag.Errorf("error setting user_id: %s", err)
+		}
+	}
+
+	if err := d.Set("status", invite.Status); err != nil {
+		return diag.Errorf("error setting status: %s", err)
+	}
+
+	for _, acceptance := range invite.Acceptances {
+		if acceptance.AcceptingTeam.ID != "" {
+			if err := d.Set("team_id", acceptance.AcceptingTeam.ID); err != nil {
+				return diag.Errorf("error setting team_id: %s", err)
+			}
+		}
+	}
+
+	return diags
+}
+
+func resourceSlackConversationConnectInviteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	channelID := d.Get("channel_id").(string)
+	teamID := d.Get("team_id").(string)
+	if teamID == "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("connect invite %s was not accepted, nothing to disconnect", d.Id()),
+			Detail:   "a pending invite is not revoked on destroy, decline it from Slack if needed",
+		})
+		return diags
+	}
+
+	if err := client.AdminConversationsDisconnectSharedContext(ctx, channelID, []string{teamID}); err != nil {
+		if err.Error() == "channel_not_found" {
+			return diags
+		}
+		return diag.Errorf("couldn't disconnect team %s from conversation %s: %s", teamID, channelID, err)
+	}
+
+	return diags
+}
+
+func findConnectInvite(ctx context.Context, client ClientInterface, inviteID string) (*ConnectInvite, error) {
+	cursor := ""
+	for {
+		invites, nextCursor, err := client.ListConnectInvitesContext(ctx, cursor)
+		if err != nil {
+			return nil, err
+		}
+		for _, invite := range invites {
+			if invite.Invite.ID == inviteID {
+				return &invite, nil
+			}
+		}
+		if nextCursor == "" {
+			return nil, nil
+		}
+		cursor = nextCursor
+	}
+}
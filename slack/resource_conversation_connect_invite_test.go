@@ -0,0 +1,57 @@
+package slack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccSlackConversationConnectInviteTest(t *testing.T) {
+	resourceName := "slack_conversation_connect_invite.test"
+	name := acctest.RandomWithPrefix(conversationNamePrefix)
+	// invites are sent to a real external organization, so its address
+	// isn't part of the tests
+	email := os.Getenv("SLACK_CONNECT_INVITE_EMAIL")
+
+	var providers []*schema.Provider
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if email == "" {
+				t.Skip("SLACK_CONNECT_INVITE_EMAIL must be set to test connect invites")
+			}
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckConversationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackConversationConnectInviteConfig(name, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", "slack_conversation.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttrPair(resourceName, "invite_id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"external_limited", "is_legacy_shared_channel"},
+			},
+		},
+	})
+}
+
+func testAccSlackConversationConnectInviteConfig(name, email string) string {
+	return testAccSlackConversationDependencyConfig(name) + fmt.Sprintf(`
+resource slack_conversation_connect_invite test {
+  channel_id = slack_conversation.test.id
+  email      = "%s"
+}
+`, email)
+}
@@ -0,0 +1,131 @@
+package slack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func testConnectInvite(id, status, teamID string) ConnectInvite {
+	invite := ConnectInvite{Status: status}
+	invite.Invite.ID = id
+	invite.Invite.RecipientEmail = "vendor@example.com"
+	invite.Channel.ID = "C123"
+	if teamID != "" {
+		invite.Acceptances = append(invite.Acceptances, struct {
+			ApprovalStatus string `json:"approval_status"`
+			AcceptingTeam  struct {
+				ID string `json:"id"`
+			} `json:"accepting_team"`
+		}{ApprovalStatus: status})
+		invite.Acceptances[0].AcceptingTeam.ID = teamID
+	}
+	return invite
+}
+
+func TestFindConnectInvite(t *testing.T) {
+	pages := map[string][]ConnectInvite{
+		"":      {testConnectInvite("I001", "approved", "T001")},
+		"page2": {testConnectInvite("I002", "pending", "")},
+	}
+	mockClient := &MockSlackClient{
+		MockListConnectInvites: func(_ context.Context, cursor string) ([]ConnectInvite, string, error) {
+			if cursor == "" {
+				return pages[cursor], "page2", nil
+			}
+			return pages[cursor], "", nil
+		},
+	}
+
+	invite, err := findConnectInvite(context.Background(), mockClient, "I002")
+	assert.NoError(t, err)
+	assert.Equal(t, "I002", invite.Invite.ID)
+
+	invite, err = findConnectInvite(context.Background(), mockClient, "I404")
+	assert.NoError(t, err)
+	assert.Nil(t, invite)
+}
+
+func TestResourceSlackConversationConnectInviteCreate(t *testing.T) {
+	var sentParams slack.InviteSharedToConversationParams
+	mockClient := &MockSlackClient{
+		MockInviteSharedToConversation: func(_ context.Context, params slack.InviteSharedToConversationParams) (string, bool, error) {
+			sentParams = params
+			return "I001", false, nil
+		},
+		MockListConnectInvites: func(_ context.Context, _ string) ([]ConnectInvite, string, error) {
+			return []ConnectInvite{testConnectInvite("I001", "approved", "T001")}, "", nil
+		},
+	}
+	config := &ProviderConfig{
+		Client:      mockClient,
+		RetryConfig: DefaultRetryConfig(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSlackConversationConnectInvite().Schema, map[string]interface{}{
+		"channel_id": "C123",
+		"email":      "vendor@example.com",
+	})
+
+	diags := resourceSlackConversationConnectInviteCreate(context.Background(), resourceData, config)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "I001", resourceData.Id())
+	assert.Equal(t, "C123", sentParams.ChannelID)
+	assert.Equal(t, []string{"vendor@example.com"}, sentParams.Emails)
+	assert.True(t, *sentParams.ExternalLimited)
+	assert.Equal(t, "approved", resourceData.Get("status"))
+	assert.Equal(t, "T001", resourceData.Get("team_id"))
+}
+
+func TestResourceSlackConversationConnectInviteDelete(t *testing.T) {
+	tests := []struct {
+		name               string
+		teamID             string
+		expectedDisconnect []string
+		expectedWarning    bool
+	}{
+		{
+			name:               "accepted invite is disconnected",
+			teamID:             "T001",
+			expectedDisconnect: []string{"T001"},
+		},
+		{
+			name:            "pending invite is left behind",
+			expectedWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var disconnected []string
+			mockClient := &MockSlackClient{
+				MockAdminConversationsDisconnectShared: func(_ context.Context, channelID string, leavingTeamIDs []string) error {
+					assert.Equal(t, "C123", channelID)
+					disconnected = leavingTeamIDs
+					return nil
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackConversationConnectInvite().Schema, map[string]interface{}{
+				"channel_id": "C123",
+				"email":      "vendor@example.com",
+			})
+			resourceData.SetId("I001")
+			assert.NoError(t, resourceData.Set("team_id", tt.teamID))
+
+			diags := resourceSlackConversationConnectInviteDelete(context.Background(), resourceData, config)
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tt.expectedWarning, len(diags) == 1)
+			assert.Equal(t, tt.expectedDisconnect, disconnected)
+		})
+	}
+}
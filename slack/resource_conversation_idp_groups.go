@@ -0,0 +1,141 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSlackConversationIDPGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackConversationIDPGroupsRead,
+		CreateContext: resourceSlackConversationIDPGroupsCreate,
+		UpdateContext: resourceSlackConversationIDPGroupsUpdate,
+		DeleteContext: resourceSlackConversationIDPGroupsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_ids": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:      schema.HashString,
+				Required: true,
+				MinItems: 1,
+			},
+			"team_id": {
+				Type:        schema.TypeString,
+				Description: "The workspace of the channel, required for channels of an Enterprise Grid workspace",
+				Optional:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceSlackConversationIDPGroupsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	channelID := d.Get("channel_id").(string)
+	teamID := d.Get("team_id").(string)
+	for _, groupID := range schemaSetToSlice(d.Get("group_ids").(*schema.Set)) {
+		if err := client.AdminConversationsRestrictAccessAddGroupContext(ctx, channelID, groupID, teamID); err != nil {
+			return diag.Errorf("couldn't restrict conversation %s to IdP group %s: %s", channelID, groupID, err)
+		}
+	}
+
+	d.SetId(channelID)
+	return resourceSlackConversationIDPGroupsRead(ctx, d, m)
+}
+
+func resourceSlackConversationIDPGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+	id := d.Id()
+	teamID := d.Get("team_id").(string)
+	var diags diag.Diagnostics
+
+	groupIDs, err := WithRetryWithResult(ctx, config.RetryConfig, func() ([]string, error) {
+		return client.AdminConversationsRestrictAccessListGroupsContext(ctx, id, teamID)
+	})
+	if err != nil {
+		if err.Error() == "channel_not_found" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("channel with ID %s not found, removing from state", id),
+			})
+			d.SetId("")
+			return diags
+		}
+		return diag.Errorf("couldn't list IdP groups of conversation %s: %s", id, err)
+	}
+
+	if err := d.Set("channel_id", id); err != nil {
+		return diag.Errorf("error setting channel_id: %s", err)
+	}
+
+	if err := d.Set("group_ids", groupIDs); err != nil {
+		return diag.Errorf("error setting group_ids: %s", err)
+	}
+
+	return diags
+}
+
+func resourceSlackConversationIDPGroupsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	id := d.Id()
+	teamID := d.Get("team_id").(string)
+
+	if d.HasChange("group_ids") {
+		o, n := d.GetChange("group_ids")
+		oldGroups := o.(*schema.Set)
+		newGroups := n.(*schema.Set)
+
+		// add the new groups first, so the channel is never left without restriction
+		for _, groupID := range schemaSetToSlice(newGroups.Difference(oldGroups)) {
+			if err := client.AdminConversationsRestrictAccessAddGroupContext(ctx, id, groupID, teamID); err != nil {
+				return diag.Errorf("couldn't restrict conversation %s to IdP group %s: %s", id, groupID, err)
+			}
+		}
+		for _, groupID := range schemaSetToSlice(oldGroups.Difference(newGroups)) {
+			if err := client.AdminConversationsRestrictAccessRemoveGroupContext(ctx, id, groupID, teamID); err != nil {
+				return diag.Errorf("couldn't remove IdP group %s from conversation %s: %s", groupID, id, err)
+			}
+		}
+	}
+
+	return resourceSlackConversationIDPGroupsRead(ctx, d, m)
+}
+
+func resourceSlackConversationIDPGroupsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	id := d.Id()
+	teamID := d.Get("team_id").(string)
+	for _, groupID := range schemaSetToSlice(d.Get("group_ids").(*schema.Set)) {
+		if err := client.AdminConversationsRestrictAccessRemoveGroupContext(ctx, id, groupID, teamID); err != nil {
+			if err.Error() == "channel_not_found" || err.Error() == "group_not_found" {
+				continue
+			}
+			return diag.Errorf("couldn't remove IdP group %s from conversation %s: %s", groupID, id, err)
+		}
+	}
+
+	return diags
+}
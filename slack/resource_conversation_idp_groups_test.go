@@ -0,0 +1,56 @@
+package slack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccSlackConversationIDPGroupsTest(t *testing.T) {
+	resourceName := "slack_conversation_idp_groups.test"
+	name := acctest.RandomWithPrefix(conversationNamePrefix)
+	// IdP groups are synced from the identity provider of the organization,
+	// they can't be created by the tests
+	groupID := os.Getenv("SLACK_IDP_GROUP_ID")
+
+	var providers []*schema.Provider
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if groupID == "" {
+				t.Skip("SLACK_IDP_GROUP_ID must be set to test IdP groups")
+			}
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckConversationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackConversationIDPGroupsConfig(name, groupID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", "slack_conversation.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "group_ids.*", groupID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"team_id"},
+			},
+		},
+	})
+}
+
+func testAccSlackConversationIDPGroupsConfig(name, groupID string) string {
+	return testAccSlackConversationDependencyConfig(name) + fmt.Sprintf(`
+resource slack_conversation_idp_groups test {
+  channel_id = slack_conversation.test.id
+  group_ids  = ["%s"]
+}
+`, groupID)
+}
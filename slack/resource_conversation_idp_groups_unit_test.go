@@ -0,0 +1,57 @@
+package slack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceSlackConversationIDPGroupsUpdate(t *testing.T) {
+	remoteGroups := []string{"S001", "S002"}
+	var added, removed []string
+	mockClient := &MockSlackClient{
+		MockAdminConversationsRestrictAccessAddGroup: func(_ context.Context, channelID, groupID, teamID string) error {
+			assert.Equal(t, "C123", channelID)
+			assert.Equal(t, "T123", teamID)
+			added = append(added, groupID)
+			remoteGroups = append(remoteGroups, groupID)
+			return nil
+		},
+		MockAdminConversationsRestrictAccessRemoveGroup: func(_ context.Context, _, groupID, _ string) error {
+			removed = append(removed, groupID)
+			remoteGroups = remove(remoteGroups, groupID)
+			return nil
+		},
+		MockAdminConversationsRestrictAccessListGroups: func(_ context.Context, _, _ string) ([]string, error) {
+			return remoteGroups, nil
+		},
+	}
+	config := &ProviderConfig{
+		Client:      mockClient,
+		RetryConfig: DefaultRetryConfig(),
+	}
+
+	state := &terraform.InstanceState{
+		ID: "C123",
+		Attributes: map[string]string{
+			"id":         "C123",
+			"channel_id": "C123",
+			"team_id":    "T123",
+		},
+	}
+	testSetStateAttribute(state, "group_ids", []string{"S001", "S002"})
+	raw := map[string]interface{}{
+		"channel_id": "C123",
+		"team_id":    "T123",
+		"group_ids":  []interface{}{"S002", "S003"},
+	}
+
+	newState, diags := testResourceApply(t, resourceSlackConversationIDPGroups(), state, raw, config)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, []string{"S003"}, added)
+	assert.Equal(t, []string{"S001"}, removed)
+	assert.Equal(t, "2", newState.Attributes["group_ids.#"])
+}
@@ -0,0 +1,181 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+)
+
+const (
+	conversationPrefWhoCanPost = "who_can_post"
+	conversationPrefCanThread  = "can_thread"
+)
+
+// ConversationPrefs holds the posting preferences of a conversation as returned
+// by admin.conversations.getConversationPrefs
+type ConversationPrefs struct {
+	WhoCanPost slack.RestrictedTo `json:"who_can_post"`
+	CanThread  slack.RestrictedTo `json:"can_thread"`
+}
+
+func resourceSlackConversationPrefs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackConversationPrefsRead,
+		CreateContext: resourceSlackConversationPrefsCreate,
+		UpdateContext: resourceSlackConversationPrefsUpdate,
+		DeleteContext: resourceSlackConversationPrefsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			conversationPrefWhoCanPost: conversationPrefSchema("Who can post in the channel"),
+			conversationPrefCanThread:  conversationPrefSchema("Who can reply in threads of the channel"),
+		},
+	}
+}
+
+func conversationPrefSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"types": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Set:      schema.HashString,
+					Optional: true,
+				},
+				"users": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Set:      schema.HashString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceSlackConversationPrefsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	channelID := d.Get("channel_id").(string)
+	if err := client.AdminConversationsSetConversationPrefsContext(ctx, channelID, expandConversationPrefs(d)); err != nil {
+		return diag.Errorf("couldn't set conversation prefs for %s: %s", channelID, err)
+	}
+
+	d.SetId(channelID)
+	return resourceSlackConversationPrefsRead(ctx, d, m)
+}
+
+func resourceSlackConversationPrefsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+	id := d.Id()
+	var diags diag.Diagnostics
+
+	prefs, err := WithRetryWithResult(ctx, config.RetryConfig, func() (*ConversationPrefs, error) {
+		return client.AdminConversationsGetConversationPrefsContext(ctx, id)
+	})
+	if err != nil {
+		if err.Error() == "channel_not_found" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("channel with ID %s not found, removing from state", id),
+			})
+			d.SetId("")
+			return diags
+		}
+		return diag.Errorf("couldn't get conversation prefs for %s: %s", id, err)
+	}
+
+	if err := d.Set("channel_id", id); err != nil {
+		return diag.Errorf("error setting channel_id: %s", err)
+	}
+
+	if err := d.Set(conversationPrefWhoCanPost, flattenConversationPref(prefs.WhoCanPost)); err != nil {
+		return diag.Errorf("error setting %s: %s", conversationPrefWhoCanPost, err)
+	}
+
+	if err := d.Set(conversationPrefCanThread, flattenConversationPref(prefs.CanThread)); err != nil {
+		return diag.Errorf("error setting %s: %s", conversationPrefCanThread, err)
+	}
+
+	return diags
+}
+
+func resourceSlackConversationPrefsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	id := d.Id()
+	if d.HasChanges(conversationPrefWhoCanPost, conversationPrefCanThread) {
+		if err := client.AdminConversationsSetConversationPrefsContext(ctx, id, expandConversationPrefs(d)); err != nil {
+			return diag.Errorf("couldn't set conversation prefs for %s: %s", id, err)
+		}
+	}
+
+	return resourceSlackConversationPrefsRead(ctx, d, m)
+}
+
+func resourceSlackConversationPrefsDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("conversation prefs of %s won't be reset on destroy", d.Id()),
+		Detail:   "the posting preferences are left as they are and are no longer managed by terraform",
+	})
+	return diags
+}
+
+// expandConversationPrefs builds the prefs argument of admin.conversations.setConversationPrefs,
+// e.g. {"who_can_post": "type:admin,user:U012AB3CD"}
+func expandConversationPrefs(d *schema.ResourceData) map[string]string {
+	prefs := map[string]string{}
+	for _, key := range []string{conversationPrefWhoCanPost, conversationPrefCanThread} {
+		raw := d.Get(key).([]interface{})
+		if len(raw) == 0 {
+			continue
+		}
+		var entries []string
+		if pref, ok := raw[0].(map[string]interface{}); ok {
+			for _, t := range schemaSetToSlice(pref["types"].(*schema.Set)) {
+				entries = append(entries, "type:"+t)
+			}
+			for _, u := range schemaSetToSlice(pref["users"].(*schema.Set)) {
+				entries = append(entries, "user:"+u)
+			}
+		}
+		prefs[key] = strings.Join(entries, ",")
+	}
+	return prefs
+}
+
+func flattenConversationPref(pref slack.RestrictedTo) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"types": pref.Type,
+			"users": pref.User,
+		},
+	}
+}
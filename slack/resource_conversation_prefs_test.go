@@ -0,0 +1,58 @@
+package slack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccSlackConversationPrefsTest(t *testing.T) {
+	resourceName := "slack_conversation_prefs.test"
+	name := acctest.RandomWithPrefix(conversationNamePrefix)
+
+	var providers []*schema.Provider
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckConversationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackConversationPrefsConfig(name, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", "slack_conversation.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "who_can_post.0.types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "who_can_post.0.types.*", "admin"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackConversationPrefsConfig(name, "ra"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "who_can_post.0.types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "who_can_post.0.types.*", "ra"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackConversationPrefsConfig(name, whoCanPost string) string {
+	return testAccSlackConversationDependencyConfig(name) + fmt.Sprintf(`
+resource slack_conversation_prefs test {
+  channel_id = slack_conversation.test.id
+
+  who_can_post {
+    types = ["%s"]
+  }
+}
+`, whoCanPost)
+}
@@ -0,0 +1,117 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandConversationPrefs(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceSlackConversationPrefs().Schema, map[string]interface{}{
+		"channel_id": "C123",
+		"who_can_post": []interface{}{
+			map[string]interface{}{
+				"types": []interface{}{"admin"},
+				"users": []interface{}{"U123", "U456"},
+			},
+		},
+	})
+
+	prefs := expandConversationPrefs(resourceData)
+
+	assert.Len(t, prefs, 1)
+	assert.ElementsMatch(t, []string{"type:admin", "user:U123", "user:U456"}, strings.Split(prefs[conversationPrefWhoCanPost], ","))
+}
+
+func TestResourceSlackConversationPrefsCreate(t *testing.T) {
+	var sentPrefs map[string]string
+	mockClient := &MockSlackClient{
+		MockAdminConversationsSetConversationPrefs: func(_ context.Context, channelID string, prefs map[string]string) error {
+			assert.Equal(t, "C123", channelID)
+			sentPrefs = prefs
+			return nil
+		},
+		MockAdminConversationsGetConversationPrefs: func(_ context.Context, _ string) (*ConversationPrefs, error) {
+			return &ConversationPrefs{
+				WhoCanPost: slack.RestrictedTo{Type: []string{"admin"}},
+				CanThread:  slack.RestrictedTo{Type: []string{"admin"}, User: []string{"U123"}},
+			}, nil
+		},
+	}
+	config := &ProviderConfig{
+		Client:      mockClient,
+		RetryConfig: DefaultRetryConfig(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSlackConversationPrefs().Schema, map[string]interface{}{
+		"channel_id": "C123",
+		"who_can_post": []interface{}{
+			map[string]interface{}{
+				"types": []interface{}{"admin"},
+			},
+		},
+	})
+
+	diags := resourceSlackConversationPrefsCreate(context.Background(), resourceData, config)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "C123", resourceData.Id())
+	assert.Equal(t, map[string]string{conversationPrefWhoCanPost: "type:admin"}, sentPrefs)
+	assert.Equal(t, "admin", resourceData.Get("who_can_post.0.types").(*schema.Set).List()[0])
+	assert.Equal(t, "U123", resourceData.Get("can_thread.0.users").(*schema.Set).List()[0])
+}
+
+func TestResourceSlackConversationPrefsRead_Errors(t *testing.T) {
+	tests := []struct {
+		name            string
+		mockError       error
+		expectedWarning string
+		expectedError   string
+	}{
+		{
+			name:            "channel not found",
+			mockError:       errors.New("channel_not_found"),
+			expectedWarning: "channel with ID C123 not found, removing from state",
+		},
+		{
+			name:          "not an admin",
+			mockError:     errors.New("not_an_admin"),
+			expectedError: "couldn't get conversation prefs for C123: not_an_admin",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockSlackClient{
+				MockAdminConversationsGetConversationPrefs: func(_ context.Context, _ string) (*ConversationPrefs, error) {
+					return nil, tt.mockError
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackConversationPrefs().Schema, map[string]interface{}{
+				"channel_id": "C123",
+			})
+			resourceData.SetId("C123")
+
+			diags := resourceSlackConversationPrefsRead(context.Background(), resourceData, config)
+
+			if tt.expectedError != "" {
+				assert.True(t, diags.HasError())
+				assert.Equal(t, tt.expectedError, diags[0].Summary)
+			} else {
+				assert.False(t, diags.HasError())
+				assert.Equal(t, tt.expectedWarning, diags[0].Summary)
+				assert.Empty(t, resourceData.Id())
+			}
+		})
+	}
+}
@@ -154,7 +154,7 @@ func testSlackConversationUpdate(t *testing.T, resourceName string, createChanne
 			ResourceName:            resourceName,
 			ImportState:             true,
 			ImportStateVerify:       true,
-			ImportStateVerifyIgnore: []string{"permanent_members", "action_on_destroy", "action_on_update_permanent_members", "adopt_existing_channel"},
+			ImportStateVerifyIgnore: []string{"permanent_members", "action_on_destroy", "action_on_update_permanent_members", "adopt_existing_channel", "force_destroy", "destroy_member_limit"},
 		},
 	}
 
@@ -329,3 +329,15 @@ resource slack_conversation %s {
 func testAccSlackConversationConfig(c slack.Channel) string {
 	return testAccSlackConversationConfigWithResourceName(c, c.Name)
 }
+
+// testAccSlackConversationDependencyConfig declares a private channel for the
+// acceptance tests of the resources that are attached to a conversation.
+func testAccSlackConversationDependencyConfig(name string) string {
+	return fmt.Sprintf(`
+resource slack_conversation test {
+  name              = "%s"
+  is_private        = true
+  permanent_members = []
+}
+`, name)
+}
@@ -0,0 +1,632 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func testConversationMockClient() *MockSlackClient {
+	return &MockSlackClient{
+		MockGetConversationInfo: func(_ context.Context, input *slack.GetConversationInfoInput) (*slack.Channel, error) {
+			return &slack.Channel{
+				GroupConversation: slack.GroupConversation{
+					Conversation: slack.Conversation{ID: input.ChannelID},
+					Name:         "my-channel",
+					Creator:      "U000",
+				},
+			}, nil
+		},
+		MockAuthTest: func() (*slack.AuthTestResponse, error) {
+			return &slack.AuthTestResponse{UserID: "UAPI", URL: "https://example.slack.com/"}, nil
+		},
+	}
+}
+
+func testConversationState(attributes map[string]string) *terraform.InstanceState {
+	state := &terraform.InstanceState{
+		ID: "C123",
+		Attributes: map[string]string{
+			"id":                                 "C123",
+			"name":                               "my-channel",
+			"is_private":                         "false",
+			"action_on_destroy":                  conversationActionOnDestroyArchive,
+			"action_on_update_permanent_members": conversationActionOnUpdatePermanentMembersKick,
+		},
+	}
+	for k, v := range attributes {
+		state.Attributes[k] = v
+	}
+	return state
+}
+
+func TestResourceSlackConversationRead_Retention(t *testing.T) {
+	tests := []struct {
+		name            string
+		retentionDays   string
+		policyDays      int
+		policyEnabled   bool
+		retentionErr    error
+		expectedDays    int
+		expectedAPICall bool
+		expectedError   string
+	}{
+		{
+			name:            "retention managed",
+			retentionDays:   "90",
+			policyDays:      30,
+			policyEnabled:   true,
+			expectedDays:    30,
+			expectedAPICall: true,
+		},
+		{
+			name:            "retention policy removed outside of terraform",
+			retentionDays:   "90",
+			policyDays:      90,
+			policyEnabled:   false,
+			expectedDays:    0,
+			expectedAPICall: true,
+		},
+		{
+			name:            "retention not managed",
+			expectedDays:    0,
+			expectedAPICall: false,
+		},
+		{
+			name:            "retention managed without an admin token",
+			retentionDays:   "90",
+			retentionErr:    errors.New("not_allowed_token_type"),
+			expectedAPICall: true,
+			expectedError:   "couldn't get conversation retention for C123: not_allowed_token_type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			mockClient := testConversationMockClient()
+			mockClient.MockAdminConversationsGetCustomRetention = func(_ context.Context, _ string) (int, bool, error) {
+				called = true
+				return tt.policyDays, tt.policyEnabled, tt.retentionErr
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			attributes := map[string]string{}
+			if tt.retentionDays != "" {
+				attributes["retention_days"] = tt.retentionDays
+			}
+			resourceData := resourceSlackConversation().Data(testConversationState(attributes))
+
+			diags := resourceSlackConversationRead(context.Background(), resourceData, config)
+
+			assert.Equal(t, tt.expectedAPICall, called)
+			if tt.expectedError != "" {
+				assert.True(t, diags.HasError())
+				assert.Equal(t, tt.expectedError, diags[0].Summary)
+				return
+			}
+			assert.Empty(t, diags)
+			assert.Equal(t, tt.expectedDays, resourceData.Get("retention_days"))
+		})
+	}
+}
+
+func TestResourceSlackConversationRead_UnmanagedMembers(t *testing.T) {
+	tests := []struct {
+		name             string
+		action           string
+		expectedMembers  []string
+		expectedWarnings int
+		expectedPages    int
+	}{
+		{
+			name:             "report mode",
+			action:           conversationActionOnUpdatePermanentMembersReport,
+			expectedMembers:  []string{"U003", "U004"},
+			expectedWarnings: 1,
+			expectedPages:    2,
+		},
+		{
+			name:   "kick mode",
+			action: conversationActionOnUpdatePermanentMembersKick,
+		},
+		{
+			name:   "none mode",
+			action: conversationActionOnUpdatePermanentMembersNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pages := 0
+			mockClient := testConversationMockClient()
+			mockClient.MockGetUsersInConversation = func(_ context.Context, params *slack.GetUsersInConversationParameters) ([]string, string, error) {
+				pages++
+				if params.Cursor == "" {
+					return []string{"U000", "UAPI", "U001"}, "next", nil
+				}
+				return []string{"U004", "U003"}, "", nil
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			state := testConversationState(map[string]string{
+				"action_on_update_permanent_members": tt.action,
+			})
+			testSetStateAttribute(state, "permanent_members", []string{"U001", "U002"})
+			resourceData := resourceSlackConversation().Data(state)
+
+			diags := resourceSlackConversationRead(context.Background(), resourceData, config)
+
+			assert.False(t, diags.HasError())
+			assert.Len(t, diags, tt.expectedWarnings)
+			assert.ElementsMatch(t, tt.expectedMembers, schemaSetToSlice(resourceData.Get("unmanaged_members").(*schema.Set)))
+			assert.Equal(t, tt.expectedPages, pages)
+			if tt.expectedWarnings > 0 {
+				assert.Equal(t, diag.Warning, diags[0].Severity)
+				assert.Contains(t, diags[0].Detail, "U003, U004")
+			}
+		})
+	}
+}
+
+func TestResourceSlackConversationUpdate_Retention(t *testing.T) {
+	tests := []struct {
+		name           string
+		retentionDays  interface{}
+		expectedSet    int
+		expectedRemove bool
+		expectedState  string
+	}{
+		{
+			name:          "change retention",
+			retentionDays: 2555,
+			expectedSet:   2555,
+			expectedState: "2555",
+		},
+		{
+			name:           "remove retention",
+			expectedRemove: true,
+			expectedState:  "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				setDays int
+				removed bool
+			)
+			mockClient := testConversationMockClient()
+			mockClient.MockAdminConversationsSetCustomRetention = func(_ context.Context, _ string, durationDays int) error {
+				setDays = durationDays
+				return nil
+			}
+			mockClient.MockAdminConversationsRemoveCustomRetention = func(_ context.Context, _ string) error {
+				removed = true
+				return nil
+			}
+			mockClient.MockAdminConversationsGetCustomRetention = func(_ context.Context, _ string) (int, bool, error) {
+				return setDays, setDays > 0, nil
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			raw := map[string]interface{}{
+				"name":       "my-channel",
+				"is_private": false,
+			}
+			if tt.retentionDays != nil {
+				raw["retention_days"] = tt.retentionDays
+			}
+			state := testConversationState(map[string]string{"retention_days": "90"})
+
+			newState, diags := testResourceApply(t, resourceSlackConversation(), state, raw, config)
+
+			assert.Empty(t, diags)
+			assert.Equal(t, tt.expectedSet, setDays)
+			assert.Equal(t, tt.expectedRemove, removed)
+			assert.Equal(t, tt.expectedState, newState.Attributes["retention_days"])
+		})
+	}
+}
+
+func TestArchivedConversationName(t *testing.T) {
+	now := time.Date(2024, time.January, 31, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "my-channel-archived-2024-01-31", archivedConversationName("my-channel", now))
+
+	longName := strings.Repeat("a", maxConversationNameLength)
+	archived := archivedConversationName(longName, now)
+	assert.Len(t, archived, maxConversationNameLength)
+	assert.True(t, strings.HasSuffix(archived, "-archived-2024-01-31"))
+
+	multibyteName := strings.Repeat("é", maxConversationNameLength)
+	archived = archivedConversationName(multibyteName, now)
+	assert.True(t, utf8.ValidString(archived))
+	assert.Equal(t, maxConversationNameLength, utf8.RuneCountInString(archived))
+	assert.Equal(t, strings.Repeat("é", maxConversationNameLength-len("-archived-2024-01-31"))+"-archived-2024-01-31", archived)
+}
+
+func TestResourceSlackConversationDelete_Actions(t *testing.T) {
+	tests := []struct {
+		name             string
+		action           string
+		deleteError      error
+		expectedCalls    []string
+		expectedSeverity diag.Severity
+		expectedDiags    int
+	}{
+		{
+			name:          "archive",
+			action:        conversationActionOnDestroyArchive,
+			expectedCalls: []string{"archive"},
+		},
+		{
+			name:             "none",
+			action:           conversationActionOnDestroyNone,
+			expectedDiags:    1,
+			expectedSeverity: diag.Warning,
+		},
+		{
+			name:          "delete",
+			action:        conversationActionOnDestroyDelete,
+			expectedCalls: []string{"delete"},
+		},
+		{
+			name:             "delete without admin token",
+			action:           conversationActionOnDestroyDelete,
+			deleteError:      errors.New("not_an_admin"),
+			expectedCalls:    []string{"delete"},
+			expectedDiags:    1,
+			expectedSeverity: diag.Error,
+		},
+		{
+			name:          "rename and archive",
+			action:        conversationActionOnDestroyRenameAndArchive,
+			expectedCalls: []string{"rename", "archive"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			mockClient := testConversationMockClient()
+			mockClient.MockArchiveConversation = func(_ context.Context, _ string) error {
+				calls = append(calls, "archive")
+				return nil
+			}
+			mockClient.MockAdminConversationsDelete = func(_ context.Context, _ string) error {
+				calls = append(calls, "delete")
+				return tt.deleteError
+			}
+			mockClient.MockRenameConversation = func(_ context.Context, _, name string) (*slack.Channel, error) {
+				assert.True(t, strings.HasPrefix(name, "my-channel-archived-"))
+				calls = append(calls, "rename")
+				return nil, nil
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := resourceSlackConversation().Data(testConversationState(map[string]string{
+				"action_on_destroy": tt.action,
+			}))
+
+			diags := resourceSlackConversationDelete(context.Background(), resourceData, config)
+
+			assert.Equal(t, tt.expectedCalls, calls)
+			assert.Len(t, diags, tt.expectedDiags)
+			if tt.expectedDiags > 0 {
+				assert.Equal(t, tt.expectedSeverity, diags[0].Severity)
+			}
+		})
+	}
+}
+
+func TestCheckConversationDestroy(t *testing.T) {
+	tests := []struct {
+		name        string
+		channel     slack.Channel
+		memberLimit int
+		expectError bool
+	}{
+		{
+			name:    "regular channel",
+			channel: slack.Channel{GroupConversation: slack.GroupConversation{Conversation: slack.Conversation{NumMembers: 2000}}},
+		},
+		{
+			name:        "general channel",
+			channel:     slack.Channel{IsGeneral: true},
+			expectError: true,
+		},
+		{
+			name:        "externally shared channel",
+			channel:     slack.Channel{GroupConversation: slack.GroupConversation{Conversation: slack.Conversation{IsExtShared: true}}},
+			expectError: true,
+		},
+		{
+			name:        "below member limit",
+			channel:     slack.Channel{GroupConversation: slack.GroupConversation{Conversation: slack.Conversation{NumMembers: 100}}},
+			memberLimit: 100,
+		},
+		{
+			name:        "above member limit",
+			channel:     slack.Channel{GroupConversation: slack.GroupConversation{Conversation: slack.Conversation{NumMembers: 2000}}},
+			memberLimit: 100,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkConversationDestroy(&tt.channel, tt.memberLimit)
+			assert.Equal(t, tt.expectError, err != nil)
+		})
+	}
+}
+
+func TestResourceSlackConversationDelete_Safeguards(t *testing.T) {
+	tests := []struct {
+		name          string
+		forceDestroy  bool
+		memberLimit   interface{}
+		numMembers    int
+		expectArchive bool
+	}{
+		{
+			name:          "refused",
+			memberLimit:   500,
+			numMembers:    2000,
+			expectArchive: false,
+		},
+		{
+			name:          "forced",
+			forceDestroy:  true,
+			memberLimit:   500,
+			numMembers:    2000,
+			expectArchive: true,
+		},
+		{
+			name:          "refused by the default limit",
+			numMembers:    101,
+			expectArchive: false,
+		},
+		{
+			name:          "under the default limit",
+			numMembers:    100,
+			expectArchive: true,
+		},
+		{
+			name:          "limit disabled",
+			memberLimit:   0,
+			numMembers:    2000,
+			expectArchive: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var archived bool
+			mockClient := testConversationMockClient()
+			mockClient.MockGetConversationInfo = func(_ context.Context, input *slack.GetConversationInfoInput) (*slack.Channel, error) {
+				assert.True(t, input.IncludeNumMembers)
+				return &slack.Channel{
+					GroupConversation: slack.GroupConversation{
+						Conversation: slack.Conversation{ID: input.ChannelID, NumMembers: tt.numMembers},
+						Name:         "my-channel",
+					},
+				}, nil
+			}
+			mockClient.MockArchiveConversation = func(_ context.Context, _ string) error {
+				archived = true
+				return nil
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			raw := map[string]interface{}{
+				"name":          "my-channel",
+				"force_destroy": tt.forceDestroy,
+			}
+			if tt.memberLimit != nil {
+				raw["destroy_member_limit"] = tt.memberLimit
+			}
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackConversation().Schema, raw)
+			resourceData.SetId("C123")
+
+			diags := resourceSlackConversationDelete(context.Background(), resourceData, config)
+
+			assert.Equal(t, tt.expectArchive, archived)
+			assert.Equal(t, !tt.expectArchive, diags.HasError())
+		})
+	}
+}
+
+func TestUpdateChannelMembers_MaxMemberRemovals(t *testing.T) {
+	tests := []struct {
+		name             string
+		providerLimit    int
+		resourceLimit    cty.Value
+		expectError      bool
+		expectedKicked   []string
+		expectedMessages []string
+	}{
+		{
+			name:           "unlimited",
+			expectedKicked: []string{"U002", "U003", "U004"},
+		},
+		{
+			name:             "provider limit exceeded",
+			providerLimit:    2,
+			expectError:      true,
+			expectedMessages: []string{"refusing to kick 3 users", "U002, U003, U004"},
+		},
+		{
+			name:           "resource limit overrides provider limit",
+			providerLimit:  2,
+			resourceLimit:  cty.NumberIntVal(5),
+			expectedKicked: []string{"U002", "U003", "U004"},
+		},
+		{
+			name:           "resource limit of 0 overrides provider limit",
+			providerLimit:  2,
+			resourceLimit:  cty.NumberIntVal(0),
+			expectedKicked: []string{"U002", "U003", "U004"},
+		},
+		{
+			name:             "resource limit exceeded",
+			resourceLimit:    cty.NumberIntVal(1),
+			expectError:      true,
+			expectedMessages: []string{"more than max_member_removals 1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var kicked []string
+			mockClient := testConversationMockClient()
+			// the members span two pages
+			mockClient.MockGetUsersInConversation = func(_ context.Context, params *slack.GetUsersInConversationParameters) ([]string, string, error) {
+				if params.Cursor == "" {
+					return []string{"U000", "UAPI", "U001"}, "next", nil
+				}
+				return []string{"U004", "U002", "U003"}, "", nil
+			}
+			mockClient.MockKickUserFromConversation = func(_ context.Context, _, user string) error {
+				kicked = append(kicked, user)
+				return nil
+			}
+			config := &ProviderConfig{
+				Client:            mockClient,
+				RetryConfig:       DefaultRetryConfig(),
+				MaxMemberRemovals: tt.providerLimit,
+			}
+
+			state := testConversationState(nil)
+			if tt.resourceLimit != cty.NilVal {
+				state.Attributes["max_member_removals"] = tt.resourceLimit.AsBigFloat().String()
+				state.RawConfig = cty.ObjectVal(map[string]cty.Value{"max_member_removals": tt.resourceLimit})
+			}
+			testSetStateAttribute(state, "permanent_members", []string{"U001"})
+			resourceData := resourceSlackConversation().Data(state)
+
+			err := updateChannelMembers(context.Background(), resourceData, config, config.RetryConfig, "C123")
+
+			assert.Equal(t, tt.expectError, err != nil)
+			assert.Equal(t, tt.expectedKicked, kicked)
+			for _, message := range tt.expectedMessages {
+				assert.Contains(t, err.Error(), message)
+			}
+		})
+	}
+}
+
+func TestResourceSlackConversationRead_Attributes(t *testing.T) {
+	mockClient := testConversationMockClient()
+	mockClient.MockGetConversationDetails = func(_ context.Context, input *slack.GetConversationInfoInput) (*ConversationInfo, error) {
+		assert.True(t, input.IncludeNumMembers)
+		channel := slack.Channel{
+			GroupConversation: slack.GroupConversation{
+				Conversation: slack.Conversation{
+					ID:            input.ChannelID,
+					NumMembers:    42,
+					ContextTeamID: "T001",
+					SharedTeamIDs: []string{"T001", "T002"},
+					PendingShared: []string{"T003"},
+				},
+				Name:    "my-channel",
+				Creator: "U000",
+				Topic:   slack.Topic{Value: "topic", Creator: "U001"},
+				Purpose: slack.Purpose{Value: "purpose", Creator: "U002"},
+			},
+			IsMember: true,
+		}
+		// Slack only returns the locale when asked to
+		if input.IncludeLocale {
+			channel.Locale = "en-GB"
+		}
+		return &ConversationInfo{Channel: channel, Updated: 1678229664302}, nil
+	}
+	config := &ProviderConfig{
+		Client:      mockClient,
+		RetryConfig: DefaultRetryConfig(),
+	}
+
+	resourceData := resourceSlackConversation().Data(testConversationState(nil))
+
+	diags := resourceSlackConversationRead(context.Background(), resourceData, config)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, 42, resourceData.Get("num_members"))
+	assert.Equal(t, true, resourceData.Get("is_member"))
+	assert.Equal(t, "T001", resourceData.Get("context_team_id"))
+	assert.ElementsMatch(t, []string{"T001", "T002"}, schemaSetToSlice(resourceData.Get("shared_team_ids").(*schema.Set)))
+	assert.ElementsMatch(t, []string{"T003"}, schemaSetToSlice(resourceData.Get("pending_shared").(*schema.Set)))
+	assert.Equal(t, "en-GB", resourceData.Get("locale"))
+	assert.Equal(t, "U001", resourceData.Get("topic_last_set_by"))
+	assert.Equal(t, "U002", resourceData.Get("purpose_last_set_by"))
+	assert.Equal(t, "https://example.slack.com/archives/C123", resourceData.Get("url"))
+	assert.Equal(t, 1678229664302, resourceData.Get("updated"))
+}
+
+func TestConversationURL(t *testing.T) {
+	assert.Equal(t, "https://example.slack.com/archives/C123", conversationURL("https://example.slack.com/", "C123"))
+	assert.Equal(t, "https://example.slack.com/archives/C123", conversationURL("https://example.slack.com", "C123"))
+	assert.Equal(t, "", conversationURL("", "C123"))
+}
+
+func TestResourceSlackConversationUpdate_MaxMemberRemovalsBeforeChanges(t *testing.T) {
+	var calls []string
+	mockClient := testConversationMockClient()
+	mockClient.MockGetUsersInConversation = func(_ context.Context, _ *slack.GetUsersInConversationParameters) ([]string, string, error) {
+		return []string{"U000", "UAPI", "U001", "U002", "U003"}, "", nil
+	}
+	mockClient.MockRenameConversation = func(_ context.Context, _, _ string) (*slack.Channel, error) {
+		calls = append(calls, "rename")
+		return &slack.Channel{}, nil
+	}
+	mockClient.MockKickUserFromConversation = func(_ context.Context, _, _ string) error {
+		calls = append(calls, "kick")
+		return nil
+	}
+	config := &ProviderConfig{
+		Client:            mockClient,
+		RetryConfig:       DefaultRetryConfig(),
+		MaxMemberRemovals: 1,
+	}
+
+	state := testConversationState(nil)
+	testSetStateAttribute(state, "permanent_members", []string{"U001", "U002", "U003"})
+	raw := map[string]interface{}{
+		"name":              "my-renamed-channel",
+		"is_private":        false,
+		"permanent_members": []interface{}{"U001"},
+	}
+
+	_, diags := testResourceApply(t, resourceSlackConversation(), state, raw, config)
+
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "refusing to kick 2 users")
+	assert.Empty(t, calls)
+}
@@ -0,0 +1,128 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+)
+
+func resourceSlackPin() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackPinRead,
+		CreateContext: resourceSlackPinCreate,
+		DeleteContext: resourceSlackPinDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSlackPinImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ts": {
+				Type:        schema.TypeString,
+				Description: "Timestamp of the message to pin",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceSlackPinCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	channelID := d.Get("channel_id").(string)
+	ts := d.Get("ts").(string)
+
+	if err := client.AddPinContext(ctx, channelID, slack.NewRefToMessage(channelID, ts)); err != nil {
+		if err.Error() != "already_pinned" {
+			return diag.Errorf("could not pin message %s in conversation %s: %s", ts, channelID, err)
+		}
+	}
+	d.SetId(conversationTimestampID(channelID, ts))
+
+	return resourceSlackPinRead(ctx, d, m)
+}
+
+func resourceSlackPinRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+	id := d.Id()
+	var diags diag.Diagnostics
+
+	channelID, ts, err := parseConversationTimestampID(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items, err := WithRetryWithResult(ctx, config.RetryConfig, func() ([]slack.Item, error) {
+		items, _, err := client.ListPinsContext(ctx, channelID)
+		return items, err
+	})
+	if err != nil && err.Error() != "channel_not_found" {
+		return diag.Errorf("couldn't list pins of conversation %s: %s", channelID, err)
+	}
+	if err != nil || !pinsContainMessage(items, ts) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("pin with ID %s not found, removing from state", id),
+		})
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("channel_id", channelID); err != nil {
+		return diag.Errorf("error setting channel_id: %s", err)
+	}
+
+	if err := d.Set("ts", ts); err != nil {
+		return diag.Errorf("error setting ts: %s", err)
+	}
+
+	return diags
+}
+
+func resourceSlackPinDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	channelID, ts, err := parseConversationTimestampID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.RemovePinContext(ctx, channelID, slack.NewRefToMessage(channelID, ts)); err != nil {
+		switch err.Error() {
+		case "no_pin", "message_not_found", "channel_not_found":
+			return diags
+		}
+		return diag.Errorf("couldn't unpin message %s: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceSlackPinImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseConversationTimestampID(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func pinsContainMessage(items []slack.Item, ts string) bool {
+	for _, item := range items {
+		if item.Type == slack.TYPE_MESSAGE && item.Message != nil && item.Message.Timestamp == ts {
+			return true
+		}
+	}
+	return false
+}
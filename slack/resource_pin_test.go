@@ -0,0 +1,51 @@
+package slack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccSlackPinTest(t *testing.T) {
+	resourceName := "slack_pin.test"
+	name := acctest.RandomWithPrefix(conversationNamePrefix)
+
+	var providers []*schema.Provider
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckConversationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackPinConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", "slack_chat_message.test", "channel_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "ts", "slack_chat_message.test", "ts"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSlackPinConfig(name string) string {
+	return testAccSlackConversationDependencyConfig(name) + `
+resource slack_chat_message test {
+  channel_id = slack_conversation.test.id
+  text       = "Please open a ticket before asking here."
+}
+
+resource slack_pin test {
+  channel_id = slack_chat_message.test.channel_id
+  ts         = slack_chat_message.test.ts
+}
+`
+}
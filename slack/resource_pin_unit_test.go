@@ -0,0 +1,151 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceSlackPinCreate(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		expectError bool
+	}{
+		{name: "pinned"},
+		{name: "already pinned", err: errors.New("already_pinned")},
+		{name: "message not found", err: errors.New("message_not_found"), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pinned slack.ItemRef
+			mockClient := &MockSlackClient{
+				MockAddPin: func(_ context.Context, _ string, item slack.ItemRef) error {
+					pinned = item
+					return tt.err
+				},
+				MockListPins: func(_ context.Context, channelID string) ([]slack.Item, *slack.Paging, error) {
+					return []slack.Item{
+						slack.NewMessageItem(channelID, &slack.Message{Msg: slack.Msg{Timestamp: "1700000000.000100"}}),
+					}, nil, nil
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackPin().Schema, map[string]interface{}{
+				"channel_id": "C123",
+				"ts":         "1700000000.000100",
+			})
+
+			diags := resourceSlackPinCreate(context.Background(), resourceData, config)
+
+			assert.Equal(t, tt.expectError, diags.HasError())
+			assert.Equal(t, slack.NewRefToMessage("C123", "1700000000.000100"), pinned)
+			if !tt.expectError {
+				assert.Equal(t, "C123/1700000000.000100", resourceData.Id())
+			}
+		})
+	}
+}
+
+func TestResourceSlackPinRead(t *testing.T) {
+	tests := []struct {
+		name        string
+		items       []slack.Item
+		err         error
+		expectedID  string
+		expectError bool
+	}{
+		{
+			name: "pinned",
+			items: []slack.Item{
+				slack.NewMessageItem("C123", &slack.Message{Msg: slack.Msg{Timestamp: "1600000000.000100"}}),
+				slack.NewMessageItem("C123", &slack.Message{Msg: slack.Msg{Timestamp: "1700000000.000100"}}),
+			},
+			expectedID: "C123/1700000000.000100",
+		},
+		{
+			name: "unpinned outside of terraform",
+			items: []slack.Item{
+				slack.NewMessageItem("C123", &slack.Message{Msg: slack.Msg{Timestamp: "1600000000.000100"}}),
+			},
+		},
+		{
+			name: "channel deleted",
+			err:  errors.New("channel_not_found"),
+		},
+		{
+			name:        "other error",
+			err:         errors.New("invalid_auth"),
+			expectedID:  "C123/1700000000.000100",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockSlackClient{
+				MockListPins: func(_ context.Context, _ string) ([]slack.Item, *slack.Paging, error) {
+					return tt.items, nil, tt.err
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackPin().Schema, map[string]interface{}{})
+			resourceData.SetId("C123/1700000000.000100")
+
+			diags := resourceSlackPinRead(context.Background(), resourceData, config)
+
+			assert.Equal(t, tt.expectedID, resourceData.Id())
+			assert.Equal(t, tt.expectError, diags.HasError())
+			if tt.expectedID != "" && !tt.expectError {
+				assert.Equal(t, "C123", resourceData.Get("channel_id"))
+				assert.Equal(t, "1700000000.000100", resourceData.Get("ts"))
+			}
+		})
+	}
+}
+
+func TestResourceSlackPinDelete(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		expectError bool
+	}{
+		{name: "unpinned"},
+		{name: "already unpinned", err: errors.New("no_pin")},
+		{name: "other error", err: errors.New("invalid_auth"), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockSlackClient{
+				MockRemovePin: func(_ context.Context, _ string, _ slack.ItemRef) error {
+					return tt.err
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackPin().Schema, map[string]interface{}{})
+			resourceData.SetId("C123/1700000000.000100")
+
+			diags := resourceSlackPinDelete(context.Background(), resourceData, config)
+
+			assert.Equal(t, tt.expectError, diags.HasError())
+		})
+	}
+}
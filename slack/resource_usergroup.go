@@ -2,25 +2,47 @@ package slack
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/slack-go/slack"
 )
 
+const (
+	userGroupOnEmptyUsersDisable     = "disable"
+	userGroupOnEmptyUsersPlaceholder = "placeholder"
+	userGroupOnEmptyUsersFail        = "fail"
+)
+
+var (
+	userGroupOnEmptyUsersValidValues = []string{
+		userGroupOnEmptyUsersDisable,
+		userGroupOnEmptyUsersPlaceholder,
+		userGroupOnEmptyUsersFail,
+	}
+
+	validateUserGroupOnEmptyUsers = validation.StringInSlice(userGroupOnEmptyUsersValidValues, false)
+)
+
 func resourceSlackUserGroup() *schema.Resource {
 	return &schema.Resource{
 		ReadContext:   resourceSlackUserGroupRead,
 		CreateContext: resourceSlackUserGroupCreate,
 		UpdateContext: resourceSlackUserGroupUpdate,
 		DeleteContext: resourceSlackUserGroupDelete,
+		CustomizeDiff: resourceSlackUserGroupCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSlackUserGroupImport,
 		},
 
+		Timeouts: resourceTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -50,6 +72,105 @@ func resourceSlackUserGroup() *schema.Resource {
 				Set:      schema.HashString,
 				Optional: true,
 			},
+			"user_emails": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "Emails of users of the usergroup, in addition to users",
+				Optional:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the usergroup is enabled, a disabled usergroup keeps its settings but can't be mentioned",
+				Optional:    true,
+				Default:     true,
+			},
+			"on_empty_users": {
+				Type:         schema.TypeString,
+				Description:  "What happens when the usergroup has no users, as Slack doesn't allow removing all of them: disable, placeholder or fail",
+				Optional:     true,
+				Default:      userGroupOnEmptyUsersFail,
+				ValidateFunc: validateUserGroupOnEmptyUsers,
+			},
+			"placeholder_user": {
+				Type:        schema.TypeString,
+				Description: "User ID kept as the only member of the usergroup when it has no users and on_empty_users is placeholder",
+				Optional:    true,
+			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Description: "Adopt an existing usergroup with the same name or handle instead of failing",
+				Optional:    true,
+				Default:     false,
+			},
+			"include_usergroups": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "IDs of usergroups whose members are members of the usergroup, in addition to users",
+				Optional:    true,
+			},
+			"effective_users": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "Members of the usergroup, including the members of user_emails and include_usergroups",
+				Computed:    true,
+			},
+			"team_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_external": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"auto_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_create": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"date_update": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"date_delete": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deleted_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"groups": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:      schema.HashString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -62,7 +183,11 @@ func resourceSlackUserGroupCreate(ctx context.Context, d *schema.ResourceData, m
 	description := d.Get("description").(string)
 	handle := d.Get("handle").(string)
 	channels := d.Get("channels").(*schema.Set)
-	users := d.Get("users").(*schema.Set)
+
+	users, diags := desiredUserGroupMembers(ctx, client, resourceRetryConfig(d, schema.TimeoutCreate), d)
+	if diags.HasError() {
+		return diags
+	}
 
 	userGroup := slack.UserGroup{
 		Name:        name,
@@ -77,17 +202,32 @@ func resourceSlackUserGroupCreate(ctx context.Context, d *schema.ResourceData, m
 		if err.Error() != "name_already_exists" && err.Error() != "handle_already_exists" {
 			return diag.Errorf("could not create usergroup %s: %s", name, err)
 		}
-		group, err := findUserGroupByName(ctx, name, true, m)
+		if !d.Get("adopt_existing").(bool) {
+			return diag.Errorf("could not create usergroup %s: %s, set adopt_existing to adopt the existing usergroup", name, err)
+		}
+		var group slack.UserGroup
+		if err.Error() == "handle_already_exists" {
+			group, err = findUserGroupByHandle(ctx, handle, true, m)
+		} else {
+			group, err = findUserGroupByName(ctx, name, true, m)
+		}
 		if err != nil {
 			return diag.Errorf("could not find usergroup %s: %s", name, err)
 		}
-		_, err = client.EnableUserGroupContext(ctx, group.ID)
+		retryConfig := resourceRetryConfig(d, schema.TimeoutCreate)
+		err = WithRetry(ctx, retryConfig, func() error {
+			_, err := client.EnableUserGroupContext(ctx, group.ID)
+			return err
+		})
 		if err != nil {
 			if err.Error() != "already_enabled" {
 				return diag.Errorf("could not enable usergroup %s (%s): %s", name, group.ID, err)
 			}
 		}
-		_, err = client.UpdateUserGroupContext(ctx, group.ID)
+		err = WithRetry(ctx, retryConfig, func() error {
+			_, err := client.UpdateUserGroupContext(ctx, group.ID, userGroupUpdateOptions(d)...)
+			return err
+		})
 		if err != nil {
 			return diag.Errorf("could not update usergroup %s (%s): %s", name, group.ID, err)
 		}
@@ -96,12 +236,21 @@ func resourceSlackUserGroupCreate(ctx context.Context, d *schema.ResourceData, m
 		d.SetId(createdUserGroup.ID)
 	}
 
-	if users.Len() > 0 {
-		_, err := client.UpdateUserGroupMembersContext(ctx, d.Id(), strings.Join(schemaSetToSlice(users), ","))
+	if len(users) > 0 {
+		err := WithRetry(ctx, resourceRetryConfig(d, schema.TimeoutCreate), func() error {
+			_, err := client.UpdateUserGroupMembersContext(ctx, d.Id(), strings.Join(users, ","))
+			return err
+		})
 		if err != nil {
 			return diag.Errorf("could not update usergroup members %s: %s", name, err)
 		}
-		schemaSetToSlice(users)
+	}
+
+	emptyDisabled := len(users) == 0 && d.Get("on_empty_users").(string) == userGroupOnEmptyUsersDisable
+	if !d.Get("enabled").(bool) || emptyDisabled {
+		if err := disableUserGroup(ctx, client, resourceRetryConfig(d, schema.TimeoutCreate), d.Id()); err != nil {
+			return diag.Errorf("could not disable usergroup %s: %s", name, err)
+		}
 	}
 	return resourceSlackUserGroupRead(ctx, d, m)
 }
@@ -109,6 +258,7 @@ func resourceSlackUserGroupCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceSlackUserGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*ProviderConfig)
 	client := config.Client
+	retryConfig := resourceRetryConfig(d, schema.TimeoutRead)
 	id := d.Id()
 	var (
 		diags      diag.Diagnostics
@@ -116,17 +266,75 @@ func resourceSlackUserGroupRead(ctx context.Context, d *schema.ResourceData, m i
 		err        error
 	)
 
-	userGroups, err = WithRetryWithResult(ctx, config.RetryConfig, func() ([]slack.UserGroup, error) {
-		return client.GetUserGroupsContext(ctx, slack.GetUserGroupsOptionIncludeUsers(true))
+	userGroups, err = WithRetryWithResult(ctx, retryConfig, func() ([]slack.UserGroup, error) {
+		return client.GetUserGroupsContext(ctx, slack.GetUserGroupsOptionIncludeDisabled(true), slack.GetUserGroupsOptionIncludeUsers(true))
 	})
 	if err != nil {
 		return diag.Errorf("couldn't get usergroups: %s", err)
 	}
 
 	for _, userGroup := range userGroups {
-		if userGroup.ID == id {
-			return updateUserGroupData(d, userGroup)
+		if userGroup.ID != id {
+			continue
+		}
+
+		configuredUsers := schemaSetToSlice(d.Get("users").(*schema.Set))
+		emails := schemaSetToSlice(d.Get("user_emails").(*schema.Set))
+		includes := schemaSetToSlice(d.Get("include_usergroups").(*schema.Set))
+		enabled := d.Get("enabled").(bool)
+		onEmptyUsers := d.Get("on_empty_users").(string)
+		placeholderUser := d.Get("placeholder_user").(string)
+		included, includedErr := includedUserGroupMembers(userGroups, includes)
+
+		diags = updateUserGroupData(d, userGroup)
+		if diags.HasError() {
+			return diags
+		}
+		if userGroup.DateDelete != 0 {
+			// a usergroup disabled by on_empty_users keeps the configured enabled
+			empty := len(configuredUsers) == 0 && len(emails) == 0 && len(included) == 0
+			if onEmptyUsers == userGroupOnEmptyUsersDisable && empty {
+				if err := d.Set("enabled", enabled); err != nil {
+					return diag.Errorf("error setting enabled: %s", err)
+				}
+				if err := d.Set("effective_users", []string{}); err != nil {
+					return diag.Errorf("error setting effective_users: %s", err)
+				}
+			}
+			return diags
+		}
+		if err := d.Set("effective_users", userGroup.Users); err != nil {
+			return diag.Errorf("error setting effective_users: %s", err)
+		}
+
+		// the placeholder user of an empty usergroup is not reported in users
+		placeholder := onEmptyUsers == userGroupOnEmptyUsersPlaceholder &&
+			len(userGroup.Users) == 1 && userGroup.Users[0] == placeholderUser
+		if placeholder {
+			included = append(included, placeholderUser)
+		}
+		if len(emails) == 0 && len(includes) == 0 && !placeholder {
+			return diags
 		}
+
+		// members added through user_emails or include_usergroups are not
+		// reported in users
+		emailIDs, emailDiags := resolveUserEmails(ctx, client, retryConfig, emails, diag.Warning)
+		diags = append(diags, emailDiags...)
+		if includedErr != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  includedErr.Error(),
+			})
+		}
+		users, userEmails := splitUserGroupMembers(userGroup.Users, configuredUsers, emailIDs, included)
+		if err := d.Set("users", users); err != nil {
+			return diag.Errorf("error setting users: %s", err)
+		}
+		if err := d.Set("user_emails", userEmails); err != nil {
+			return diag.Errorf("error setting user_emails: %s", err)
+		}
+		return diags
 	}
 	diags = append(diags, diag.Diagnostic{
 		Severity: diag.Warning,
@@ -136,6 +344,9 @@ func resourceSlackUserGroupRead(ctx context.Context, d *schema.ResourceData, m i
 	return diags
 }
 
+// errUserGroupNotFound is returned by findUserGroup when no usergroup matches
+var errUserGroupNotFound = errors.New("could not find usergroup")
+
 func findUserGroup(
 	ctx context.Context,
 	includeDisabled bool,
@@ -161,75 +372,374 @@ func findUserGroup(
 		}
 	}
 
-	return slack.UserGroup{}, fmt.Errorf("could not find usergroup")
+	return slack.UserGroup{}, errUserGroupNotFound
 }
 
 func findUserGroupByName(ctx context.Context, name string, includeDisabled bool, m interface{}) (slack.UserGroup, error) {
 	ug, err := findUserGroup(ctx, includeDisabled, m, func(ug slack.UserGroup) bool {
 		return ug.Name == name
 	})
-	if err != nil {
-		return slack.UserGroup{}, fmt.Errorf("could not find usergroup with name: %s", name)
+	if errors.Is(err, errUserGroupNotFound) {
+		return slack.UserGroup{}, fmt.Errorf("%w with name: %s", errUserGroupNotFound, name)
 	}
-	return ug, nil
+	return ug, err
+}
+
+func findUserGroupByHandle(ctx context.Context, handle string, includeDisabled bool, m interface{}) (slack.UserGroup, error) {
+	ug, err := findUserGroup(ctx, includeDisabled, m, func(ug slack.UserGroup) bool {
+		return ug.Handle == handle
+	})
+	if errors.Is(err, errUserGroupNotFound) {
+		return slack.UserGroup{}, fmt.Errorf("%w with handle: %s", errUserGroupNotFound, handle)
+	}
+	return ug, err
 }
 
 func findUserGroupByID(ctx context.Context, id string, includeDisabled bool, m interface{}) (slack.UserGroup, error) {
 	ug, err := findUserGroup(ctx, includeDisabled, m, func(ug slack.UserGroup) bool {
 		return ug.ID == id
 	})
-	if err != nil {
-		return slack.UserGroup{}, fmt.Errorf("could not find usergroup with id: %s", id)
+	if errors.Is(err, errUserGroupNotFound) {
+		return slack.UserGroup{}, fmt.Errorf("%w with id: %s", errUserGroupNotFound, id)
 	}
-	return ug, nil
+	return ug, err
 }
 
 func resourceSlackUserGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*ProviderConfig)
 	client := config.Client
+	retryConfig := resourceRetryConfig(d, schema.TimeoutUpdate)
 
 	id := d.Id()
 	name := d.Get("name").(string)
-	description := d.Get("description").(string)
-	handle := d.Get("handle").(string)
-	channels := d.Get("channels").(*schema.Set)
-	users := d.Get("users").(*schema.Set)
+	onEmptyUsers := d.Get("on_empty_users").(string)
 
-	updateUserGroupOptions := []slack.UpdateUserGroupsOption{
-		slack.UpdateUserGroupsOptionName(name),
-		slack.UpdateUserGroupsOptionChannels(schemaSetToSlice(channels)),
-		slack.UpdateUserGroupsOptionDescription(&description),
-		slack.UpdateUserGroupsOptionHandle(handle),
+	var users []string
+	membersChanged := d.HasChanges("users", "user_emails", "include_usergroups", "effective_users")
+	if membersChanged {
+		var diags diag.Diagnostics
+		users, diags = desiredUserGroupMembers(ctx, client, retryConfig, d)
+		if diags.HasError() {
+			return diags
+		}
 	}
-	_, err := client.UpdateUserGroupContext(ctx, id, updateUserGroupOptions...)
+	// Slack doesn't allow removing all the users of a usergroup
+	emptied := membersChanged && len(users) == 0
+	if emptied {
+		switch onEmptyUsers {
+		case userGroupOnEmptyUsersPlaceholder:
+			placeholderUser := d.Get("placeholder_user").(string)
+			if placeholderUser == "" {
+				return diag.Errorf("could not update usergroup members %s: placeholder_user is not set", name)
+			}
+			users = []string{placeholderUser}
+		case userGroupOnEmptyUsersFail:
+			return diag.Errorf("could not update usergroup members %s: the usergroup would have no users", name)
+		}
+	}
+
+	// the usergroup is enabled before being updated when enabled becomes true,
+	// and disabled after when it becomes false. A usergroup that stays disabled
+	// is updated while disabled. A usergroup disabled by on_empty_users is
+	// enabled again once it has users.
+	enabled := d.Get("enabled").(bool)
+	reenable := membersChanged && len(users) > 0 && onEmptyUsers == userGroupOnEmptyUsersDisable
+	if enabled && (d.HasChange("enabled") || reenable) {
+		err := WithRetry(ctx, retryConfig, func() error {
+			_, err := client.EnableUserGroupContext(ctx, id)
+			return err
+		})
+		if err != nil && err.Error() != "already_enabled" {
+			return diag.Errorf("could not enable usergroup %s: %s", name, err)
+		}
+	}
+
+	err := WithRetry(ctx, retryConfig, func() error {
+		_, err := client.UpdateUserGroupContext(ctx, id, userGroupUpdateOptions(d)...)
+		return err
+	})
 	if err != nil {
 		return diag.Errorf("could not update usergroup %s: %s", name, err)
 	}
 
-	if d.HasChanges("users") {
-		_, err := client.UpdateUserGroupMembersContext(ctx, id, strings.Join(schemaSetToSlice(users), ","))
+	if membersChanged && len(users) > 0 {
+		err := WithRetry(ctx, retryConfig, func() error {
+			_, err := client.UpdateUserGroupMembersContext(ctx, id, strings.Join(users, ","))
+			return err
+		})
 		if err != nil {
 			return diag.Errorf("could not update usergroup members %s: %s", name, err)
 		}
-		schemaSetToSlice(users)
+	}
+
+	if (d.HasChange("enabled") && !enabled) || (enabled && emptied && onEmptyUsers == userGroupOnEmptyUsersDisable) {
+		if err := disableUserGroup(ctx, client, retryConfig, id); err != nil {
+			return diag.Errorf("could not disable usergroup %s: %s", name, err)
+		}
 	}
 	return resourceSlackUserGroupRead(ctx, d, m)
 }
 
+func userGroupUpdateOptions(d *schema.ResourceData) []slack.UpdateUserGroupsOption {
+	description := d.Get("description").(string)
+	return []slack.UpdateUserGroupsOption{
+		slack.UpdateUserGroupsOptionName(d.Get("name").(string)),
+		slack.UpdateUserGroupsOptionChannels(schemaSetToSlice(d.Get("channels").(*schema.Set))),
+		slack.UpdateUserGroupsOptionDescription(&description),
+		slack.UpdateUserGroupsOptionHandle(d.Get("handle").(string)),
+	}
+}
+
 func resourceSlackUserGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	config := m.(*ProviderConfig)
 	client := config.Client
 
 	id := d.Id()
-	_, err := client.DisableUserGroupContext(ctx, id)
-	if err != nil {
+	if err := disableUserGroup(ctx, client, resourceRetryConfig(d, schema.TimeoutDelete), id); err != nil {
 		return diag.FromErr(err)
 	}
 
 	return diags
 }
 
+// resourceSlackUserGroupImport accepts the ID of the usergroup, its handle
+// prefixed with @ or its name prefixed with name:
+func resourceSlackUserGroupImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	var (
+		userGroup slack.UserGroup
+		err       error
+	)
+	switch {
+	case strings.HasPrefix(id, "@"):
+		userGroup, err = findUserGroupByHandle(ctx, strings.TrimPrefix(id, "@"), true, m)
+	case strings.HasPrefix(id, "name:"):
+		userGroup, err = findUserGroupByName(ctx, strings.TrimPrefix(id, "name:"), true, m)
+	default:
+		return []*schema.ResourceData{d}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(userGroup.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceSlackUserGroupCustomizeDiff recomputes effective_users on every plan
+// when include_usergroups is set, so changes to the included usergroups show
+// as drift, and applies on_empty_users to a usergroup that would have no users
+func resourceSlackUserGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	includes := schemaSetToSlice(d.Get("include_usergroups").(*schema.Set))
+	if d.Id() != "" && contains(includes, d.Id()) {
+		return fmt.Errorf("usergroup %s can't include itself", d.Id())
+	}
+	membersChanged := d.HasChanges("users", "user_emails", "include_usergroups")
+	for _, key := range []string{"users", "user_emails", "include_usergroups", "placeholder_user"} {
+		if !d.NewValueKnown(key) {
+			if membersChanged {
+				return d.SetNewComputed("effective_users")
+			}
+			return nil
+		}
+	}
+
+	users := schemaSetToSlice(d.Get("users").(*schema.Set))
+	emails := schemaSetToSlice(d.Get("user_emails").(*schema.Set))
+	if len(includes) == 0 {
+		if !membersChanged {
+			return nil
+		}
+		if len(users) == 0 && len(emails) == 0 {
+			if _, err := emptyUserGroupMembers(d); err != nil {
+				return err
+			}
+		}
+		return d.SetNewComputed("effective_users")
+	}
+
+	config := m.(*ProviderConfig)
+	emailIDs, diags := resolveUserEmails(ctx, config.Client, config.RetryConfig, emails, diag.Error)
+	if diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+	included, err := getIncludedUserGroupMembers(ctx, config.Client, config.RetryConfig, includes)
+	if err != nil {
+		return err
+	}
+
+	members := userGroupMembers(users, emailIDs, included)
+	effectiveUsers := schemaSetToSlice(d.Get("effective_users").(*schema.Set))
+	sort.Strings(effectiveUsers)
+	if strings.Join(members, ",") == strings.Join(effectiveUsers, ",") {
+		return nil
+	}
+	if len(members) == 0 {
+		if members, err = emptyUserGroupMembers(d); err != nil {
+			return err
+		}
+	}
+	if strings.Join(members, ",") == strings.Join(effectiveUsers, ",") {
+		return nil
+	}
+	return d.SetNew("effective_users", members)
+}
+
+// emptyUserGroupMembers applies on_empty_users at plan time to a usergroup that
+// would have no users, and returns the members it would have instead. A new
+// usergroup can be created without users.
+func emptyUserGroupMembers(d *schema.ResourceDiff) ([]string, error) {
+	if d.Id() == "" {
+		return nil, nil
+	}
+	switch d.Get("on_empty_users").(string) {
+	case userGroupOnEmptyUsersPlaceholder:
+		placeholderUser := d.Get("placeholder_user").(string)
+		if placeholderUser == "" {
+			return nil, fmt.Errorf("placeholder_user must be set when on_empty_users is %s", userGroupOnEmptyUsersPlaceholder)
+		}
+		return []string{placeholderUser}, nil
+	case userGroupOnEmptyUsersFail:
+		return nil, fmt.Errorf("usergroup %s would have no users, which Slack doesn't allow: set on_empty_users to %s or %s", d.Id(), userGroupOnEmptyUsersDisable, userGroupOnEmptyUsersPlaceholder)
+	}
+	return nil, nil
+}
+
+// desiredUserGroupMembers returns the members the usergroup should have, from
+// users, user_emails and include_usergroups
+func desiredUserGroupMembers(ctx context.Context, client ClientInterface, retryConfig *RetryConfig, d *schema.ResourceData) ([]string, diag.Diagnostics) {
+	emails := schemaSetToSlice(d.Get("user_emails").(*schema.Set))
+	emailIDs, diags := resolveUserEmails(ctx, client, retryConfig, emails, diag.Error)
+	if diags.HasError() {
+		return nil, diags
+	}
+	includes := schemaSetToSlice(d.Get("include_usergroups").(*schema.Set))
+	included, err := getIncludedUserGroupMembers(ctx, client, retryConfig, includes)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	users := schemaSetToSlice(d.Get("users").(*schema.Set))
+	return userGroupMembers(users, emailIDs, included), diags
+}
+
+// resolveUserEmails returns the ID of the user of each of emails, with a
+// diagnostic of the given severity for every email that doesn't resolve
+func resolveUserEmails(ctx context.Context, client ClientInterface, retryConfig *RetryConfig, emails []string, severity diag.Severity) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	emailIDs := map[string]string{}
+	for _, email := range emails {
+		user, err := WithRetryWithResult(ctx, retryConfig, func() (*slack.User, error) {
+			return client.GetUserByEmailContext(ctx, email)
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: severity,
+				Summary:  fmt.Sprintf("couldn't find user with email %s: %s", email, err),
+			})
+			continue
+		}
+		emailIDs[email] = user.ID
+	}
+	return emailIDs, diags
+}
+
+func getIncludedUserGroupMembers(ctx context.Context, client ClientInterface, retryConfig *RetryConfig, includes []string) ([]string, error) {
+	if len(includes) == 0 {
+		return nil, nil
+	}
+	userGroups, err := WithRetryWithResult(ctx, retryConfig, func() ([]slack.UserGroup, error) {
+		return client.GetUserGroupsContext(ctx, slack.GetUserGroupsOptionIncludeDisabled(true), slack.GetUserGroupsOptionIncludeUsers(true))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get usergroups: %w", err)
+	}
+	return includedUserGroupMembers(userGroups, includes)
+}
+
+// includedUserGroupMembers returns the members of the usergroups of includes.
+// A disabled usergroup has no members.
+func includedUserGroupMembers(userGroups []slack.UserGroup, includes []string) ([]string, error) {
+	var members, missing []string
+	for _, id := range includes {
+		found := false
+		for _, userGroup := range userGroups {
+			if userGroup.ID != id {
+				continue
+			}
+			found = true
+			for _, user := range userGroup.Users {
+				if !contains(members, user) {
+					members = append(members, user)
+				}
+			}
+		}
+		if !found {
+			missing = append(missing, id)
+		}
+	}
+	sort.Strings(members)
+	if len(missing) > 0 {
+		return members, fmt.Errorf("couldn't find included usergroups %s", strings.Join(missing, ", "))
+	}
+	return members, nil
+}
+
+// userGroupMembers returns the sorted union of users, the users of user_emails
+// and the members of the included usergroups
+func userGroupMembers(users []string, emailIDs map[string]string, included []string) []string {
+	var members []string
+	add := func(id string) {
+		if !contains(members, id) {
+			members = append(members, id)
+		}
+	}
+	for _, id := range users {
+		add(id)
+	}
+	for _, id := range emailIDs {
+		add(id)
+	}
+	for _, id := range included {
+		add(id)
+	}
+	sort.Strings(members)
+	return members
+}
+
+// splitUserGroupMembers splits the members of a usergroup between the users
+// and the emails of user_emails. A member that comes only from user_emails or
+// an included usergroup isn't reported in users, while a member that is in
+// none of them is, so it shows as drift.
+func splitUserGroupMembers(members, configuredUsers []string, emailIDs map[string]string, included []string) ([]string, []string) {
+	var users, emails []string
+	for _, member := range members {
+		byEmail := false
+		for _, id := range emailIDs {
+			byEmail = byEmail || id == member
+		}
+		if !(byEmail || contains(included, member)) || contains(configuredUsers, member) {
+			users = append(users, member)
+		}
+	}
+	for email, id := range emailIDs {
+		if contains(members, id) {
+			emails = append(emails, email)
+		}
+	}
+	return users, emails
+}
+
+func disableUserGroup(ctx context.Context, client ClientInterface, retryConfig *RetryConfig, id string) error {
+	err := WithRetry(ctx, retryConfig, func() error {
+		_, err := client.DisableUserGroupContext(ctx, id)
+		return err
+	})
+	if err != nil && err.Error() != "already_disabled" {
+		return err
+	}
+	return nil
+}
+
 func updateUserGroupData(d *schema.ResourceData, userGroup slack.UserGroup) diag.Diagnostics {
 	if userGroup.ID == "" {
 		return diag.Errorf("error setting id: returned usergroup does not have an id")
@@ -252,8 +762,59 @@ func updateUserGroupData(d *schema.ResourceData, userGroup slack.UserGroup) diag
 		return diag.Errorf("error setting channels: %s", err)
 	}
 
-	if err := d.Set("users", userGroup.Users); err != nil {
-		return diag.Errorf("error setting users: %s", err)
+	// Slack doesn't list the members of a disabled usergroup
+	if userGroup.DateDelete == 0 {
+		if err := d.Set("users", userGroup.Users); err != nil {
+			return diag.Errorf("error setting users: %s", err)
+		}
+	}
+
+	if err := d.Set("enabled", userGroup.DateDelete == 0); err != nil {
+		return diag.Errorf("error setting enabled: %s", err)
+	}
+
+	if err := d.Set("team_id", userGroup.TeamID); err != nil {
+		return diag.Errorf("error setting team_id: %s", err)
+	}
+
+	if err := d.Set("is_external", userGroup.IsExternal); err != nil {
+		return diag.Errorf("error setting is_external: %s", err)
+	}
+
+	if err := d.Set("auto_type", userGroup.AutoType); err != nil {
+		return diag.Errorf("error setting auto_type: %s", err)
+	}
+
+	if err := d.Set("date_create", int(userGroup.DateCreate)); err != nil {
+		return diag.Errorf("error setting date_create: %s", err)
+	}
+
+	if err := d.Set("date_update", int(userGroup.DateUpdate)); err != nil {
+		return diag.Errorf("error setting date_update: %s", err)
+	}
+
+	if err := d.Set("date_delete", int(userGroup.DateDelete)); err != nil {
+		return diag.Errorf("error setting date_delete: %s", err)
+	}
+
+	if err := d.Set("created_by", userGroup.CreatedBy); err != nil {
+		return diag.Errorf("error setting created_by: %s", err)
+	}
+
+	if err := d.Set("updated_by", userGroup.UpdatedBy); err != nil {
+		return diag.Errorf("error setting updated_by: %s", err)
+	}
+
+	if err := d.Set("deleted_by", userGroup.DeletedBy); err != nil {
+		return diag.Errorf("error setting deleted_by: %s", err)
+	}
+
+	if err := d.Set("user_count", userGroup.UserCount); err != nil {
+		return diag.Errorf("error setting user_count: %s", err)
+	}
+
+	if err := d.Set("groups", userGroup.Prefs.Groups); err != nil {
+		return diag.Errorf("error setting groups: %s", err)
 	}
 
 	return nil
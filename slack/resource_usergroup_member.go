@@ -0,0 +1,172 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const userGroupMemberIDFormat = "<usergroup_id>/<user_id>"
+
+// userGroupLocks serialises the changes to the members of a usergroup, as
+// usergroups.users.update replaces the entire list of members
+var userGroupLocks sync.Map
+
+func lockUserGroup(id string) func() {
+	lock, _ := userGroupLocks.LoadOrStore(id, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+func resourceSlackUserGroupMember() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackUserGroupMemberRead,
+		CreateContext: resourceSlackUserGroupMemberCreate,
+		DeleteContext: resourceSlackUserGroupMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSlackUserGroupMemberImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"usergroup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSlackUserGroupMemberCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	usergroupID := d.Get("usergroup_id").(string)
+	userID := d.Get("user_id").(string)
+
+	unlock := lockUserGroup(usergroupID)
+	defer unlock()
+
+	userGroup, err := findUserGroupByID(ctx, usergroupID, true, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Slack doesn't list the members of a disabled usergroup, so they would be
+	// replaced by the user
+	if userGroup.DateDelete != 0 {
+		return diag.Errorf("could not add user %s to usergroup %s: the usergroup is disabled", userID, usergroupID)
+	}
+
+	if !contains(userGroup.Users, userID) {
+		users := append(userGroup.Users, userID)
+		err := WithRetry(ctx, config.RetryConfig, func() error {
+			_, err := client.UpdateUserGroupMembersContext(ctx, usergroupID, strings.Join(users, ","))
+			return err
+		})
+		if err != nil {
+			return diag.Errorf("could not add user %s to usergroup %s: %s", userID, usergroupID, err)
+		}
+	}
+	d.SetId(compositeID(usergroupID, userID))
+
+	return resourceSlackUserGroupMemberRead(ctx, d, m)
+}
+
+func resourceSlackUserGroupMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	var diags diag.Diagnostics
+
+	usergroupID, userID, err := parseCompositeID(id, userGroupMemberIDFormat)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userGroup, err := findUserGroupByID(ctx, usergroupID, true, m)
+	notFound := errors.Is(err, errUserGroupNotFound)
+	if err != nil && !notFound {
+		return diag.Errorf("couldn't get usergroup %s: %s", usergroupID, err)
+	}
+	// Slack doesn't list the members of a disabled usergroup
+	if notFound || (userGroup.DateDelete == 0 && !contains(userGroup.Users, userID)) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("usergroup member with ID %s not found, removing from state", id),
+		})
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("usergroup_id", usergroupID); err != nil {
+		return diag.Errorf("error setting usergroup_id: %s", err)
+	}
+
+	if err := d.Set("user_id", userID); err != nil {
+		return diag.Errorf("error setting user_id: %s", err)
+	}
+
+	return diags
+}
+
+func resourceSlackUserGroupMemberDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	config := m.(*ProviderConfig)
+	client := config.Client
+
+	usergroupID, userID, err := parseCompositeID(d.Id(), userGroupMemberIDFormat)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	unlock := lockUserGroup(usergroupID)
+	defer unlock()
+
+	userGroup, err := findUserGroupByID(ctx, usergroupID, true, m)
+	if errors.Is(err, errUserGroupNotFound) {
+		return diags
+	}
+	if err != nil {
+		return diag.Errorf("couldn't get usergroup %s: %s", usergroupID, err)
+	}
+	if !contains(userGroup.Users, userID) {
+		return diags
+	}
+
+	users := remove(userGroup.Users, userID)
+	if len(users) == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("user %s won't be removed from usergroup %s", userID, usergroupID),
+			Detail:   "Slack doesn't allow a usergroup without members and it is the last one",
+		})
+		return diags
+	}
+
+	err = WithRetry(ctx, config.RetryConfig, func() error {
+		_, err := client.UpdateUserGroupMembersContext(ctx, usergroupID, strings.Join(users, ","))
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("could not remove user %s from usergroup %s: %s", userID, usergroupID, err)
+	}
+
+	return diags
+}
+
+func resourceSlackUserGroupMemberImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseCompositeID(d.Id(), userGroupMemberIDFormat); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,47 @@
+package slack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccSlackUserGroupMemberTest(t *testing.T) {
+	resourceName := "slack_usergroup_member.test"
+	name := acctest.RandomWithPrefix(userGroupResourceNamePrefix)
+
+	var providers []*schema.Provider
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			createTestUserGroup(t, name)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackUserGroupMemberConfig(name, testUser00.id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "usergroup_id", "data.slack_usergroup.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "user_id", testUser00.id),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSlackUserGroupMemberConfig(name, userID string) string {
+	return testAccSlackUserGroupDependencyConfig(name) + fmt.Sprintf(`
+resource slack_usergroup_member test {
+  usergroup_id = data.slack_usergroup.test.id
+  user_id      = "%s"
+}
+`, userID)
+}
@@ -0,0 +1,196 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+// testUserGroupMembersClient returns a client whose usergroup S123 keeps the
+// members written to it
+func testUserGroupMembersClient(members []string) (*MockSlackClient, func() []string) {
+	var mu sync.Mutex
+	client := &MockSlackClient{
+		MockGetUserGroups: func(_ context.Context, _ ...slack.GetUserGroupsOption) ([]slack.UserGroup, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return []slack.UserGroup{{ID: "S123", Users: append([]string{}, members...)}}, nil
+		},
+		MockUpdateUserGroupMembers: func(_ context.Context, _, users string) (slack.UserGroup, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			members = strings.Split(users, ",")
+			return slack.UserGroup{}, nil
+		},
+	}
+	return client, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return members
+	}
+}
+
+func TestResourceSlackUserGroupMemberCreate(t *testing.T) {
+	mockClient, members := testUserGroupMembersClient([]string{"U001"})
+	config := &ProviderConfig{
+		Client:      mockClient,
+		RetryConfig: DefaultRetryConfig(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSlackUserGroupMember().Schema, map[string]interface{}{
+		"usergroup_id": "S123",
+		"user_id":      "U002",
+	})
+
+	diags := resourceSlackUserGroupMemberCreate(context.Background(), resourceData, config)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "S123/U002", resourceData.Id())
+	assert.Equal(t, []string{"U001", "U002"}, members())
+}
+
+func TestResourceSlackUserGroupMemberCreate_Disabled(t *testing.T) {
+	updated := false
+	mockClient := &MockSlackClient{
+		MockGetUserGroups: func(_ context.Context, _ ...slack.GetUserGroupsOption) ([]slack.UserGroup, error) {
+			return []slack.UserGroup{{ID: "S123", DateDelete: 1700000000}}, nil
+		},
+		MockUpdateUserGroupMembers: func(_ context.Context, _, _ string) (slack.UserGroup, error) {
+			updated = true
+			return slack.UserGroup{}, nil
+		},
+	}
+	config := &ProviderConfig{
+		Client:      mockClient,
+		RetryConfig: DefaultRetryConfig(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSlackUserGroupMember().Schema, map[string]interface{}{
+		"usergroup_id": "S123",
+		"user_id":      "U002",
+	})
+
+	diags := resourceSlackUserGroupMemberCreate(context.Background(), resourceData, config)
+
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "the usergroup is disabled")
+	assert.False(t, updated)
+}
+
+func TestResourceSlackUserGroupMemberCreate_Concurrent(t *testing.T) {
+	mockClient, members := testUserGroupMembersClient([]string{"U000"})
+	config := &ProviderConfig{
+		Client:      mockClient,
+		RetryConfig: DefaultRetryConfig(),
+	}
+
+	var wg sync.WaitGroup
+	expected := []string{"U000"}
+	for i := 1; i <= 10; i++ {
+		userID := fmt.Sprintf("U%03d", i)
+		expected = append(expected, userID)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackUserGroupMember().Schema, map[string]interface{}{
+				"usergroup_id": "S123",
+				"user_id":      userID,
+			})
+			assert.Empty(t, resourceSlackUserGroupMemberCreate(context.Background(), resourceData, config))
+		}()
+	}
+	wg.Wait()
+
+	assert.ElementsMatch(t, expected, members())
+}
+
+func TestResourceSlackUserGroupMemberRead(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		expectedID string
+	}{
+		{
+			name:       "member",
+			id:         "S123/U001",
+			expectedID: "S123/U001",
+		},
+		{
+			name: "removed outside of terraform",
+			id:   "S123/U002",
+		},
+		{
+			name: "usergroup not found",
+			id:   "S999/U001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient, _ := testUserGroupMembersClient([]string{"U001"})
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackUserGroupMember().Schema, map[string]interface{}{})
+			resourceData.SetId(tt.id)
+
+			diags := resourceSlackUserGroupMemberRead(context.Background(), resourceData, config)
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tt.expectedID, resourceData.Id())
+		})
+	}
+}
+
+func TestResourceSlackUserGroupMemberDelete(t *testing.T) {
+	tests := []struct {
+		name            string
+		members         []string
+		expectedMembers []string
+		expectWarning   bool
+	}{
+		{
+			name:            "removes only the user",
+			members:         []string{"U001", "U002", "U003"},
+			expectedMembers: []string{"U001", "U003"},
+		},
+		{
+			name:            "already removed",
+			members:         []string{"U001"},
+			expectedMembers: []string{"U001"},
+		},
+		{
+			name:            "last member",
+			members:         []string{"U002"},
+			expectedMembers: []string{"U002"},
+			expectWarning:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient, members := testUserGroupMembersClient(tt.members)
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackUserGroupMember().Schema, map[string]interface{}{})
+			resourceData.SetId("S123/U002")
+
+			diags := resourceSlackUserGroupMemberDelete(context.Background(), resourceData, config)
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tt.expectWarning, len(diags) == 1)
+			assert.Equal(t, tt.expectedMembers, members())
+		})
+	}
+}
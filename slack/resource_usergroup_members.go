@@ -0,0 +1,125 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+)
+
+func resourceSlackUserGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceSlackUserGroupMembersRead,
+		CreateContext: resourceSlackUserGroupMembersCreate,
+		UpdateContext: resourceSlackUserGroupMembersUpdate,
+		DeleteContext: resourceSlackUserGroupMembersDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"usergroup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"users": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "The entire list of users of the usergroup",
+				Required:    true,
+				MinItems:    1,
+			},
+		},
+	}
+}
+
+func resourceSlackUserGroupMembersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	usergroupID := d.Get("usergroup_id").(string)
+
+	if err := updateUserGroupMembers(ctx, d, m, usergroupID); err != nil {
+		return diag.Errorf("could not update usergroup members %s: %s", usergroupID, err)
+	}
+	d.SetId(usergroupID)
+
+	return resourceSlackUserGroupMembersRead(ctx, d, m)
+}
+
+func resourceSlackUserGroupMembersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*ProviderConfig)
+	client := config.Client
+	id := d.Id()
+	var diags diag.Diagnostics
+
+	userGroups, err := WithRetryWithResult(ctx, config.RetryConfig, func() ([]slack.UserGroup, error) {
+		return client.GetUserGroupsContext(ctx, slack.GetUserGroupsOptionIncludeDisabled(true), slack.GetUserGroupsOptionIncludeUsers(true))
+	})
+	if err != nil {
+		return diag.Errorf("couldn't get usergroups: %s", err)
+	}
+
+	for _, userGroup := range userGroups {
+		if userGroup.ID != id {
+			continue
+		}
+
+		if err := d.Set("usergroup_id", userGroup.ID); err != nil {
+			return diag.Errorf("error setting usergroup_id: %s", err)
+		}
+
+		// Slack doesn't list the members of a disabled usergroup
+		if userGroup.DateDelete == 0 {
+			if err := d.Set("users", userGroup.Users); err != nil {
+				return diag.Errorf("error setting users: %s", err)
+			}
+		}
+		return diags
+	}
+
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("usergroup with ID %s not found, removing from state", id),
+	})
+	d.SetId("")
+	return diags
+}
+
+func resourceSlackUserGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+
+	if d.HasChange("users") {
+		if err := updateUserGroupMembers(ctx, d, m, id); err != nil {
+			return diag.Errorf("could not update usergroup members %s: %s", id, err)
+		}
+	}
+
+	return resourceSlackUserGroupMembersRead(ctx, d, m)
+}
+
+func resourceSlackUserGroupMembersDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("members of usergroup %s won't be removed on destroy", d.Id()),
+		Detail:   "Slack doesn't allow a usergroup without members, the members are left as they are and are no longer managed by terraform",
+	})
+	return diags
+}
+
+func updateUserGroupMembers(ctx context.Context, d *schema.ResourceData, m interface{}, usergroupID string) error {
+	config := m.(*ProviderConfig)
+	client := config.Client
+	users := schemaSetToSlice(d.Get("users").(*schema.Set))
+
+	return WithRetry(ctx, config.RetryConfig, func() error {
+		_, err := client.UpdateUserGroupMembersContext(ctx, usergroupID, strings.Join(users, ","))
+		return err
+	})
+}
@@ -0,0 +1,94 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccSlackUserGroupMembersTest(t *testing.T) {
+	resourceName := "slack_usergroup_members.test"
+	name := acctest.RandomWithPrefix(userGroupResourceNamePrefix)
+
+	var providers []*schema.Provider
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			createTestUserGroup(t, name)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackUserGroupMembersConfig(name, []string{testUser00.id}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "usergroup_id", "data.slack_usergroup.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", testUser00.id),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackUserGroupMembersConfig(name, []string{testUser00.id, testUser01.id}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", testUser00.id),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", testUser01.id),
+				),
+			},
+		},
+	})
+}
+
+// createTestUserGroup creates a usergroup outside of terraform, for the
+// resources that manage the members of an existing usergroup. It is disabled
+// once the test is done.
+func createTestUserGroup(t *testing.T, name string) *slack.UserGroup {
+	client, err := sharedSlackClient()
+	require.NoError(t, err, "error getting client: %s", err)
+
+	c := client.(*slack.Client)
+	userGroup, err := c.CreateUserGroupContext(context.Background(), slack.UserGroup{
+		Name:   name,
+		Handle: name,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = c.DisableUserGroupContext(context.Background(), userGroup.ID)
+	})
+	return &userGroup
+}
+
+// testAccSlackUserGroupDependencyConfig looks up the usergroup created by
+// createTestUserGroup.
+func testAccSlackUserGroupDependencyConfig(name string) string {
+	return fmt.Sprintf(`
+data slack_usergroup test {
+  name = "%s"
+}
+`, name)
+}
+
+func testAccSlackUserGroupMembersConfig(name string, users []string) string {
+	var quoted []string
+	for _, user := range users {
+		quoted = append(quoted, fmt.Sprintf(`"%s"`, user))
+	}
+
+	return testAccSlackUserGroupDependencyConfig(name) + fmt.Sprintf(`
+resource slack_usergroup_members test {
+  usergroup_id = data.slack_usergroup.test.id
+  users        = [%s]
+}
+`, strings.Join(quoted, ","))
+}
@@ -0,0 +1,102 @@
+package slack
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceSlackUserGroupMembersCreate(t *testing.T) {
+	var (
+		updatedID    string
+		updatedUsers string
+	)
+	mockClient := &MockSlackClient{
+		MockUpdateUserGroupMembers: func(_ context.Context, userGroupID, users string) (slack.UserGroup, error) {
+			updatedID = userGroupID
+			updatedUsers = users
+			return slack.UserGroup{}, nil
+		},
+		MockGetUserGroups: func(_ context.Context, _ ...slack.GetUserGroupsOption) ([]slack.UserGroup, error) {
+			return []slack.UserGroup{
+				{ID: "S001", Users: []string{"U009"}},
+				{ID: "S123", Users: []string{"U001", "U002"}},
+			}, nil
+		},
+	}
+	config := &ProviderConfig{
+		Client:      mockClient,
+		RetryConfig: DefaultRetryConfig(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSlackUserGroupMembers().Schema, map[string]interface{}{
+		"usergroup_id": "S123",
+		"users":        []interface{}{"U001", "U002"},
+	})
+
+	diags := resourceSlackUserGroupMembersCreate(context.Background(), resourceData, config)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "S123", updatedID)
+	assert.ElementsMatch(t, []string{"U001", "U002"}, strings.Split(updatedUsers, ","))
+	assert.Equal(t, "S123", resourceData.Id())
+	assert.ElementsMatch(t, []string{"U001", "U002"}, schemaSetToSlice(resourceData.Get("users").(*schema.Set)))
+}
+
+func TestResourceSlackUserGroupMembersRead(t *testing.T) {
+	tests := []struct {
+		name          string
+		userGroups    []slack.UserGroup
+		expectedID    string
+		expectedUsers []string
+	}{
+		{
+			name:          "members changed outside of terraform",
+			userGroups:    []slack.UserGroup{{ID: "S123", Users: []string{"U001", "U003"}}},
+			expectedID:    "S123",
+			expectedUsers: []string{"U001", "U003"},
+		},
+		{
+			name:          "disabled usergroup",
+			userGroups:    []slack.UserGroup{{ID: "S123", DateDelete: 1700000000}},
+			expectedID:    "S123",
+			expectedUsers: []string{"U001"},
+		},
+		{
+			name:          "usergroup not found",
+			userGroups:    []slack.UserGroup{{ID: "S001", Users: []string{"U001"}}},
+			expectedID:    "",
+			expectedUsers: []string{"U001"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockSlackClient{
+				MockGetUserGroups: func(_ context.Context, _ ...slack.GetUserGroupsOption) ([]slack.UserGroup, error) {
+					return tt.userGroups, nil
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackUserGroupMembers().Schema, map[string]interface{}{
+				"usergroup_id": "S123",
+				"users":        []interface{}{"U001"},
+			})
+			resourceData.SetId("S123")
+
+			diags := resourceSlackUserGroupMembersRead(context.Background(), resourceData, config)
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tt.expectedID, resourceData.Id())
+			assert.ElementsMatch(t, tt.expectedUsers, schemaSetToSlice(resourceData.Get("users").(*schema.Set)))
+		})
+	}
+}
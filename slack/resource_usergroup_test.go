@@ -124,9 +124,10 @@ func testSlackUserGroupUpdate(t *testing.T, resourceName string, createChannel s
 			),
 		},
 		{
-			ResourceName:      resourceName,
-			ImportState:       true,
-			ImportStateVerify: true,
+			ResourceName:            resourceName,
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"adopt_existing", "on_empty_users"},
 		},
 	}
 
@@ -0,0 +1,688 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testUserGroupState(attributes map[string]string) *terraform.InstanceState {
+	state := &terraform.InstanceState{
+		ID: "S123",
+		Attributes: map[string]string{
+			"id":      "S123",
+			"name":    "my-group",
+			"handle":  "my-group",
+			"enabled": "true",
+		},
+	}
+	for k, v := range attributes {
+		state.Attributes[k] = v
+	}
+	return state
+}
+
+func TestResourceSlackUserGroupRead_Disabled(t *testing.T) {
+	mockClient := &MockSlackClient{
+		MockGetUserGroups: func(_ context.Context, options ...slack.GetUserGroupsOption) ([]slack.UserGroup, error) {
+			params := &slack.GetUserGroupsParams{}
+			for _, option := range options {
+				option(params)
+			}
+			assert.True(t, params.IncludeDisabled)
+			return []slack.UserGroup{
+				{ID: "S123", Name: "my-group", Handle: "my-group", DateDelete: 1700000000},
+			}, nil
+		},
+	}
+	config := &ProviderConfig{
+		Client:      mockClient,
+		RetryConfig: DefaultRetryConfig(),
+	}
+
+	state := testUserGroupState(nil)
+	testSetStateAttribute(state, "users", []string{"U001"})
+	resourceData := resourceSlackUserGroup().Data(state)
+
+	diags := resourceSlackUserGroupRead(context.Background(), resourceData, config)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "S123", resourceData.Id())
+	assert.Equal(t, false, resourceData.Get("enabled"))
+	assert.ElementsMatch(t, []string{"U001"}, schemaSetToSlice(resourceData.Get("users").(*schema.Set)))
+}
+
+func TestResourceSlackUserGroupUpdate_Enabled(t *testing.T) {
+	tests := []struct {
+		name          string
+		enabled       string
+		configEnabled bool
+		expectedCalls []string
+	}{
+		{
+			name:          "disable",
+			enabled:       "true",
+			configEnabled: false,
+			expectedCalls: []string{"update", "disable"},
+		},
+		{
+			name:          "enable",
+			enabled:       "false",
+			configEnabled: true,
+			expectedCalls: []string{"enable", "update"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			mockClient := &MockSlackClient{
+				MockGetUserGroups: func(_ context.Context, _ ...slack.GetUserGroupsOption) ([]slack.UserGroup, error) {
+					return []slack.UserGroup{{ID: "S123", Name: "my-group", Handle: "my-group"}}, nil
+				},
+				MockEnableUserGroup: func(_ context.Context, _ string, _ ...slack.EnableUserGroupOption) (slack.UserGroup, error) {
+					calls = append(calls, "enable")
+					return slack.UserGroup{}, nil
+				},
+				MockDisableUserGroup: func(_ context.Context, _ string, _ ...slack.DisableUserGroupOption) (slack.UserGroup, error) {
+					calls = append(calls, "disable")
+					return slack.UserGroup{}, nil
+				},
+				MockUpdateUserGroup: func(_ context.Context, _ string, _ ...slack.UpdateUserGroupsOption) (slack.UserGroup, error) {
+					calls = append(calls, "update")
+					return slack.UserGroup{}, nil
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			state := testUserGroupState(map[string]string{"enabled": tt.enabled})
+			raw := map[string]interface{}{
+				"name":    "my-group",
+				"handle":  "my-group",
+				"enabled": tt.configEnabled,
+			}
+
+			_, diags := testResourceApply(t, resourceSlackUserGroup(), state, raw, config)
+
+			assert.Empty(t, diags)
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func TestSplitUserGroupMembers(t *testing.T) {
+	tests := []struct {
+		name            string
+		members         []string
+		configuredUsers []string
+		emailIDs        map[string]string
+		included        []string
+		expectedUsers   []string
+		expectedEmails  []string
+	}{
+		{
+			name:            "users and emails",
+			members:         []string{"U001", "U002"},
+			configuredUsers: []string{"U001"},
+			emailIDs:        map[string]string{"jane@example.com": "U002"},
+			expectedUsers:   []string{"U001"},
+			expectedEmails:  []string{"jane@example.com"},
+		},
+		{
+			name:            "user in both",
+			members:         []string{"U001"},
+			configuredUsers: []string{"U001"},
+			emailIDs:        map[string]string{"john@example.com": "U001"},
+			expectedUsers:   []string{"U001"},
+			expectedEmails:  []string{"john@example.com"},
+		},
+		{
+			name:            "member removed outside of terraform",
+			members:         []string{"U001"},
+			configuredUsers: []string{"U001"},
+			emailIDs:        map[string]string{"jane@example.com": "U002"},
+			expectedUsers:   []string{"U001"},
+		},
+		{
+			name:            "member added outside of terraform",
+			members:         []string{"U001", "U002", "U003"},
+			configuredUsers: []string{"U001"},
+			emailIDs:        map[string]string{"jane@example.com": "U002"},
+			expectedUsers:   []string{"U001", "U003"},
+			expectedEmails:  []string{"jane@example.com"},
+		},
+		{
+			name:            "members of included usergroups",
+			members:         []string{"U001", "U002", "U003"},
+			configuredUsers: []string{"U001", "U002"},
+			included:        []string{"U002", "U003"},
+			expectedUsers:   []string{"U001", "U002"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users, emails := splitUserGroupMembers(tt.members, tt.configuredUsers, tt.emailIDs, tt.included)
+			assert.Equal(t, tt.expectedUsers, users)
+			assert.Equal(t, tt.expectedEmails, emails)
+		})
+	}
+}
+
+func TestResourceSlackUserGroupCreate_UserEmails(t *testing.T) {
+	tests := []struct {
+		name            string
+		emails          []interface{}
+		expectedMembers string
+		expectedErrors  int
+	}{
+		{
+			name:            "emails resolved",
+			emails:          []interface{}{"jane@example.com", "john@example.com"},
+			expectedMembers: "U001,U002,U003",
+		},
+		{
+			name:           "emails not found",
+			emails:         []interface{}{"jane@example.com", "nobody@example.com", "noone@example.com"},
+			expectedErrors: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var members string
+			mockClient := &MockSlackClient{
+				MockGetUserByEmail: func(_ context.Context, email string) (*slack.User, error) {
+					switch email {
+					case "jane@example.com":
+						return &slack.User{ID: "U002"}, nil
+					case "john@example.com":
+						return &slack.User{ID: "U003"}, nil
+					}
+					return nil, errors.New("users_not_found")
+				},
+				MockCreateUserGroup: func(_ context.Context, _ slack.UserGroup, _ ...slack.CreateUserGroupOption) (slack.UserGroup, error) {
+					return slack.UserGroup{ID: "S123"}, nil
+				},
+				MockUpdateUserGroupMembers: func(_ context.Context, _, users string) (slack.UserGroup, error) {
+					members = users
+					return slack.UserGroup{}, nil
+				},
+				MockGetUserGroups: func(_ context.Context, _ ...slack.GetUserGroupsOption) ([]slack.UserGroup, error) {
+					return []slack.UserGroup{{ID: "S123", Name: "my-group", Users: []string{"U001", "U002", "U003"}}}, nil
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackUserGroup().Schema, map[string]interface{}{
+				"name":        "my-group",
+				"users":       []interface{}{"U001"},
+				"user_emails": tt.emails,
+			})
+
+			diags := resourceSlackUserGroupCreate(context.Background(), resourceData, config)
+
+			assert.Len(t, diags, tt.expectedErrors)
+			for _, d := range diags {
+				assert.Equal(t, diag.Error, d.Severity)
+				assert.Contains(t, d.Summary, "couldn't find user with email no")
+			}
+			assert.Equal(t, tt.expectedMembers, members)
+			if tt.expectedErrors == 0 {
+				assert.ElementsMatch(t, []string{"U001"}, schemaSetToSlice(resourceData.Get("users").(*schema.Set)))
+				assert.ElementsMatch(t, tt.emails, resourceData.Get("user_emails").(*schema.Set).List())
+			}
+		})
+	}
+}
+
+func TestResourceSlackUserGroup_IncludeUserGroups(t *testing.T) {
+	tests := []struct {
+		name            string
+		childMembers    []string
+		includes        []interface{}
+		expectedMembers string
+		expectedError   string
+	}{
+		{
+			name:            "child usergroup changed",
+			childMembers:    []string{"U002", "U003"},
+			includes:        []interface{}{"S456"},
+			expectedMembers: "U001,U002,U003",
+		},
+		{
+			name:          "included usergroup not found",
+			childMembers:  []string{"U002"},
+			includes:      []interface{}{"S789"},
+			expectedError: "couldn't find included usergroups S789",
+		},
+		{
+			name:          "usergroup includes itself",
+			childMembers:  []string{"U002"},
+			includes:      []interface{}{"S123"},
+			expectedError: "usergroup S123 can't include itself",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members := []string{"U001", "U002"}
+			var updatedMembers string
+			mockClient := &MockSlackClient{
+				MockGetUserGroups: func(_ context.Context, _ ...slack.GetUserGroupsOption) ([]slack.UserGroup, error) {
+					return []slack.UserGroup{
+						{ID: "S123", Name: "my-group", Handle: "my-group", Users: members},
+						{ID: "S456", Name: "child", Handle: "child", Users: tt.childMembers},
+					}, nil
+				},
+				MockUpdateUserGroupMembers: func(_ context.Context, _, users string) (slack.UserGroup, error) {
+					updatedMembers = users
+					members = strings.Split(users, ",")
+					return slack.UserGroup{}, nil
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			state := testUserGroupState(nil)
+			testSetStateAttribute(state, "users", []string{"U001"})
+			testSetStateAttribute(state, "include_usergroups", []string{"S456"})
+			testSetStateAttribute(state, "effective_users", []string{"U001", "U002"})
+			raw := map[string]interface{}{
+				"name":               "my-group",
+				"handle":             "my-group",
+				"users":              []interface{}{"U001"},
+				"include_usergroups": tt.includes,
+			}
+
+			r := resourceSlackUserGroup()
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), config)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			newState, diags := r.Apply(context.Background(), state, diff, config)
+
+			assert.Empty(t, diags)
+			assert.Equal(t, tt.expectedMembers, updatedMembers)
+			resourceData := r.Data(newState)
+			assert.ElementsMatch(t, []string{"U001"}, schemaSetToSlice(resourceData.Get("users").(*schema.Set)))
+			assert.ElementsMatch(t, []string{"U001", "U002", "U003"}, schemaSetToSlice(resourceData.Get("effective_users").(*schema.Set)))
+		})
+	}
+}
+
+func TestResourceSlackUserGroupImport(t *testing.T) {
+	tests := []struct {
+		name          string
+		importID      string
+		expectedID    string
+		expectedError string
+	}{
+		{
+			name:       "ID",
+			importID:   "S123",
+			expectedID: "S123",
+		},
+		{
+			name:       "handle",
+			importID:   "@oncall-payments",
+			expectedID: "S456",
+		},
+		{
+			name:       "name",
+			importID:   "name:Payments On-call",
+			expectedID: "S456",
+		},
+		{
+			name:       "disabled usergroup",
+			importID:   "@old-team",
+			expectedID: "S789",
+		},
+		{
+			name:          "handle not found",
+			importID:      "@nobody",
+			expectedError: "could not find usergroup with handle: nobody",
+		},
+		{
+			name:          "name not found",
+			importID:      "name:Nobody",
+			expectedError: "could not find usergroup with name: Nobody",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockSlackClient{
+				MockGetUserGroups: func(_ context.Context, options ...slack.GetUserGroupsOption) ([]slack.UserGroup, error) {
+					params := &slack.GetUserGroupsParams{}
+					for _, option := range options {
+						option(params)
+					}
+					assert.True(t, params.IncludeDisabled)
+					return []slack.UserGroup{
+						{ID: "S123", Name: "my-group", Handle: "my-group"},
+						{ID: "S456", Name: "Payments On-call", Handle: "oncall-payments"},
+						{ID: "S789", Name: "Old team", Handle: "old-team", DateDelete: 1700000000},
+					}, nil
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := resourceSlackUserGroup().Data(&terraform.InstanceState{ID: tt.importID})
+
+			results, err := resourceSlackUserGroupImport(context.Background(), resourceData, config)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			assert.Equal(t, tt.expectedID, results[0].Id())
+		})
+	}
+}
+
+func TestResourceSlackUserGroupCreate_AdoptExisting(t *testing.T) {
+	tests := []struct {
+		name          string
+		adoptExisting bool
+		createError   string
+		rateLimited   bool
+		expectedID    string
+		expectedError string
+	}{
+		{
+			name:          "collision without adopt_existing",
+			createError:   "name_already_exists",
+			expectedError: "could not create usergroup my-group: name_already_exists, set adopt_existing to adopt the existing usergroup",
+		},
+		{
+			name:          "name collision",
+			adoptExisting: true,
+			createError:   "name_already_exists",
+			expectedID:    "S456",
+		},
+		{
+			name:          "handle collision",
+			adoptExisting: true,
+			createError:   "handle_already_exists",
+			expectedID:    "S789",
+		},
+		{
+			name:          "rate limited while adopting",
+			adoptExisting: true,
+			createError:   "name_already_exists",
+			rateLimited:   true,
+			expectedID:    "S456",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *slack.UpdateUserGroupsParams
+			var updatedID string
+			enableCalls, updateCalls := 0, 0
+			rateLimitedOnce := func(calls int) error {
+				if tt.rateLimited && calls == 1 {
+					return &slack.RateLimitedError{RetryAfter: time.Millisecond}
+				}
+				return nil
+			}
+			mockClient := &MockSlackClient{
+				MockCreateUserGroup: func(_ context.Context, _ slack.UserGroup, _ ...slack.CreateUserGroupOption) (slack.UserGroup, error) {
+					return slack.UserGroup{}, errors.New(tt.createError)
+				},
+				MockGetUserGroups: func(_ context.Context, _ ...slack.GetUserGroupsOption) ([]slack.UserGroup, error) {
+					return []slack.UserGroup{
+						{ID: "S456", Name: "my-group", Handle: "old-handle", DateDelete: 1700000000},
+						{ID: "S789", Name: "other-group", Handle: "my-handle"},
+					}, nil
+				},
+				MockEnableUserGroup: func(_ context.Context, _ string, _ ...slack.EnableUserGroupOption) (slack.UserGroup, error) {
+					enableCalls++
+					if err := rateLimitedOnce(enableCalls); err != nil {
+						return slack.UserGroup{}, err
+					}
+					return slack.UserGroup{}, errors.New("already_enabled")
+				},
+				MockUpdateUserGroup: func(_ context.Context, id string, options ...slack.UpdateUserGroupsOption) (slack.UserGroup, error) {
+					updateCalls++
+					if err := rateLimitedOnce(updateCalls); err != nil {
+						return slack.UserGroup{}, err
+					}
+					updatedID = id
+					updated = &slack.UpdateUserGroupsParams{}
+					for _, option := range options {
+						option(updated)
+					}
+					return slack.UserGroup{}, nil
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSlackUserGroup().Schema, map[string]interface{}{
+				"name":           "my-group",
+				"handle":         "my-handle",
+				"description":    "My group",
+				"channels":       []interface{}{"C001"},
+				"adopt_existing": tt.adoptExisting,
+			})
+
+			diags := resourceSlackUserGroupCreate(context.Background(), resourceData, config)
+
+			if tt.expectedError != "" {
+				require.Len(t, diags, 1)
+				assert.Equal(t, tt.expectedError, diags[0].Summary)
+				assert.Nil(t, updated)
+				return
+			}
+			assert.Equal(t, tt.expectedID, updatedID)
+			require.NotNil(t, updated)
+			assert.Equal(t, "my-group", updated.Name)
+			assert.Equal(t, "my-handle", updated.Handle)
+			require.NotNil(t, updated.Description)
+			assert.Equal(t, "My group", *updated.Description)
+			require.NotNil(t, updated.Channels)
+			assert.Equal(t, []string{"C001"}, *updated.Channels)
+		})
+	}
+}
+
+func TestResourceSlackUserGroupUpdate_OnEmptyUsers(t *testing.T) {
+	tests := []struct {
+		name            string
+		onEmptyUsers    string
+		placeholderUser string
+		stateUsers      []string
+		disabled        bool
+		configUsers     []interface{}
+		expectedError   string
+		expectedCalls   []string
+		expectedEnabled bool
+		expectedMembers []string
+	}{
+		{
+			name:          "fail",
+			onEmptyUsers:  "fail",
+			stateUsers:    []string{"U001"},
+			configUsers:   []interface{}{},
+			expectedError: "usergroup S123 would have no users, which Slack doesn't allow: set on_empty_users to disable or placeholder",
+		},
+		{
+			name:          "placeholder without placeholder_user",
+			onEmptyUsers:  "placeholder",
+			stateUsers:    []string{"U001"},
+			configUsers:   []interface{}{},
+			expectedError: "placeholder_user must be set when on_empty_users is placeholder",
+		},
+		{
+			name:            "placeholder",
+			onEmptyUsers:    "placeholder",
+			placeholderUser: "U999",
+			stateUsers:      []string{"U001"},
+			configUsers:     []interface{}{},
+			expectedCalls:   []string{"update", "members U999"},
+			expectedEnabled: true,
+			expectedMembers: []string{"U999"},
+		},
+		{
+			name:            "disable",
+			onEmptyUsers:    "disable",
+			stateUsers:      []string{"U001"},
+			configUsers:     []interface{}{},
+			expectedCalls:   []string{"update", "disable"},
+			expectedEnabled: true,
+			expectedMembers: []string{},
+		},
+		{
+			name:            "enable disabled usergroup with users",
+			onEmptyUsers:    "disable",
+			disabled:        true,
+			configUsers:     []interface{}{"U001"},
+			expectedCalls:   []string{"enable", "update", "members U001"},
+			expectedEnabled: true,
+			expectedMembers: []string{"U001"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			userGroup := slack.UserGroup{ID: "S123", Name: "my-group", Handle: "my-group", Users: tt.stateUsers}
+			if tt.disabled {
+				userGroup.DateDelete = 1700000000
+			}
+			mockClient := &MockSlackClient{
+				MockGetUserGroups: func(_ context.Context, _ ...slack.GetUserGroupsOption) ([]slack.UserGroup, error) {
+					return []slack.UserGroup{userGroup}, nil
+				},
+				MockEnableUserGroup: func(_ context.Context, _ string, _ ...slack.EnableUserGroupOption) (slack.UserGroup, error) {
+					calls = append(calls, "enable")
+					userGroup.DateDelete = 0
+					return slack.UserGroup{}, nil
+				},
+				MockDisableUserGroup: func(_ context.Context, _ string, _ ...slack.DisableUserGroupOption) (slack.UserGroup, error) {
+					calls = append(calls, "disable")
+					userGroup.DateDelete = 1700000000
+					return slack.UserGroup{}, nil
+				},
+				MockUpdateUserGroup: func(_ context.Context, _ string, _ ...slack.UpdateUserGroupsOption) (slack.UserGroup, error) {
+					calls = append(calls, "update")
+					return slack.UserGroup{}, nil
+				},
+				MockUpdateUserGroupMembers: func(_ context.Context, _, users string) (slack.UserGroup, error) {
+					calls = append(calls, "members "+users)
+					userGroup.Users = strings.Split(users, ",")
+					return slack.UserGroup{}, nil
+				},
+			}
+			config := &ProviderConfig{
+				Client:      mockClient,
+				RetryConfig: DefaultRetryConfig(),
+			}
+
+			state := testUserGroupState(map[string]string{
+				"on_empty_users":   tt.onEmptyUsers,
+				"placeholder_user": tt.placeholderUser,
+			})
+			testSetStateAttribute(state, "users", tt.stateUsers)
+			testSetStateAttribute(state, "effective_users", tt.stateUsers)
+			raw := map[string]interface{}{
+				"name":             "my-group",
+				"handle":           "my-group",
+				"users":            tt.configUsers,
+				"on_empty_users":   tt.onEmptyUsers,
+				"placeholder_user": tt.placeholderUser,
+			}
+
+			r := resourceSlackUserGroup()
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), config)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			newState, diags := r.Apply(context.Background(), state, diff, config)
+
+			assert.Empty(t, diags)
+			assert.Equal(t, tt.expectedCalls, calls)
+			resourceData := r.Data(newState)
+			assert.Equal(t, tt.expectedEnabled, resourceData.Get("enabled"))
+			assert.ElementsMatch(t, tt.configUsers, resourceData.Get("users").(*schema.Set).List())
+			assert.ElementsMatch(t, tt.expectedMembers, schemaSetToSlice(resourceData.Get("effective_users").(*schema.Set)))
+
+			diff, err = r.Diff(context.Background(), newState, terraform.NewResourceConfigRaw(raw), config)
+			require.NoError(t, err)
+			assert.True(t, diff.Empty(), "plan after apply should be empty")
+		})
+	}
+}
+
+func TestUpdateUserGroupData_Metadata(t *testing.T) {
+	userGroup := slack.UserGroup{
+		ID:          "S123",
+		TeamID:      "T123",
+		Name:        "my-group",
+		Handle:      "my-group",
+		Description: "My group",
+		IsExternal:  true,
+		AutoType:    "admin",
+		DateCreate:  1600000000,
+		DateUpdate:  1700000000,
+		CreatedBy:   "U001",
+		UpdatedBy:   "U002",
+		UserCount:   2,
+		Users:       []string{"U001", "U002"},
+		Prefs:       slack.UserGroupPrefs{Channels: []string{"C001"}, Groups: []string{"G001"}},
+	}
+
+	for name, r := range map[string]*schema.Resource{
+		"resource":    resourceSlackUserGroup(),
+		"data source": dataSourceUserGroup(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			resourceData := r.Data(&terraform.InstanceState{})
+
+			diags := updateUserGroupData(resourceData, userGroup)
+
+			assert.Empty(t, diags)
+			assert.Equal(t, "T123", resourceData.Get("team_id"))
+			assert.Equal(t, true, resourceData.Get("is_external"))
+			assert.Equal(t, "admin", resourceData.Get("auto_type"))
+			assert.Equal(t, 1600000000, resourceData.Get("date_create"))
+			assert.Equal(t, 1700000000, resourceData.Get("date_update"))
+			assert.Equal(t, 0, resourceData.Get("date_delete"))
+			assert.Equal(t, "U001", resourceData.Get("created_by"))
+			assert.Equal(t, "U002", resourceData.Get("updated_by"))
+			assert.Equal(t, "", resourceData.Get("deleted_by"))
+			assert.Equal(t, 2, resourceData.Get("user_count"))
+			assert.ElementsMatch(t, []string{"G001"}, schemaSetToSlice(resourceData.Get("groups").(*schema.Set)))
+		})
+	}
+}